@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Command export writes the Terraform configuration and import blocks of the resources of an existing tenant.
+//
+// It reads its credentials from the same environment variables as the provider:
+//
+//	ATLASSIAN_OPS_CLOUD_ID=... ATLASSIAN_OPS_DOMAIN_NAME=... ATLASSIAN_OPS_API_EMAIL_ADDRESS=... \
+//	ATLASSIAN_OPS_API_TOKEN=... go run ./cmd/export -organization-id <organization id> -output tenant.tf
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider"
+)
+
+func main() {
+	var organizationId, output string
+
+	flag.StringVar(&organizationId, "organization-id", "", "id of the organization of the teams, teams are not exported without it")
+	flag.StringVar(&output, "output", "", "file to write the configuration to, defaults to stdout")
+	flag.Parse()
+
+	productType := os.Getenv("ATLASSIAN_OPS_PRODUCT_TYPE")
+	if productType == "" {
+		productType = "jira-service-desk"
+	}
+	emailAddress := os.Getenv("ATLASSIAN_OPS_API_EMAIL_ADDRESS")
+	if emailAddress == "" {
+		emailAddress = os.Getenv("ATLASSIAN_OPS_API_USERNAME")
+	}
+
+	for name, value := range map[string]string{
+		"ATLASSIAN_OPS_CLOUD_ID":          os.Getenv("ATLASSIAN_OPS_CLOUD_ID"),
+		"ATLASSIAN_OPS_DOMAIN_NAME":       os.Getenv("ATLASSIAN_OPS_DOMAIN_NAME"),
+		"ATLASSIAN_OPS_API_EMAIL_ADDRESS": emailAddress,
+		"ATLASSIAN_OPS_API_TOKEN":         os.Getenv("ATLASSIAN_OPS_API_TOKEN"),
+	} {
+		if value == "" {
+			log.Fatalf("%s must be set", name)
+		}
+	}
+
+	configuration := dto.NewAtlassianOpsProviderModel(
+		productType,
+		os.Getenv("ATLASSIAN_OPS_CLOUD_ID"),
+		os.Getenv("ATLASSIAN_OPS_DOMAIN_NAME"),
+		emailAddress,
+		os.Getenv("ATLASSIAN_OPS_API_TOKEN"),
+		os.Getenv("ATLASSIAN_OPS_API_ORG_ADMIN_TOKEN"),
+		3,
+		5*time.Second,
+		20*time.Second,
+		os.Getenv("ATLASSIAN_OPS_STAGING") == "1",
+	)
+
+	var w io.Writer = os.Stdout
+	if output != "" {
+		file, err := os.Create(output)
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+		defer file.Close()
+		w = file
+	}
+
+	exporter := provider.NewExporter(configuration, organizationId)
+	if err := exporter.Export(context.Background(), w); err != nil {
+		log.Fatal(err.Error())
+	}
+
+	for _, warning := range exporter.Warnings {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", warning)
+	}
+}
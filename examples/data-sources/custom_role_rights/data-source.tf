@@ -0,0 +1,15 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+data "atlassian-operations_custom_role_rights" "all" {}
+
+# Grant every alert related right
+resource "atlassian-operations_custom_role" "alert_admin" {
+  name           = "Alert Administrator"
+  granted_rights = [for right in data.atlassian-operations_custom_role_rights.all.rights : right if startswith(right, "alert-")]
+}
@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+# Get a JSM service, or a Compass component when product_type is "compass", by its ID
+data "atlassian-operations_service" "example" {
+  id = "b7d1a3c5-2e4f-4a6b-8c9d-0e1f2a3b4c5d"
+}
+
+# Or look it up by its exact name
+data "atlassian-operations_service" "by_name" {
+  name = "Payments API"
+}
@@ -0,0 +1,13 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+# List the tier 1 services owned by a team
+data "atlassian-operations_services" "example" {
+  owner = "438a6ccd-7daf-4afb-b0ee-9b440256a0a6"
+  tier  = 1
+}
@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+resource "atlassian-operations_api_integration" "example" {
+  name    = "api integration"
+  enabled = true
+  type    = "API"
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+# Fetch the API key, e.g. to pass it to a secrets manager, without persisting it to the plan or state of the resources
+# reading it. The key is never reset by the ephemeral resource, change rotate_key_trigger of the integration to rotate it.
+ephemeral "atlassian-operations_integration_api_key" "example" {
+  integration_id = atlassian-operations_api_integration.example.id
+}
@@ -0,0 +1,17 @@
+resource "atlassian-operations_routing_rule" "business_hours" {
+  team_id  = atlassian-operations_team.example.id
+  name     = "Business hours"
+  timezone = "Europe/Istanbul"
+
+  criteria = {
+    type = "match-all"
+  }
+
+  # Monday to Friday, from 09:00 to 17:30
+  time_restriction = provider::atlassian-operations::business_hours("mon-fri", "09:00", "17:30")
+
+  notify = {
+    type = "escalation"
+    id   = atlassian-operations_escalation.example.id
+  }
+}
@@ -0,0 +1,15 @@
+resource "atlassian-operations_notification_policy" "example" {
+  name    = "Auto close"
+  type    = "notification"
+  team_id = atlassian-operations_team.example.id
+  enabled = true
+
+  # Closes alerts after 150 minutes
+  auto_close_action = provider::atlassian-operations::duration("2h30m")
+
+  # Merged with the other attributes of the action
+  deduplication_action = merge(provider::atlassian-operations::duration("1d"), {
+    deduplication_action_type = "valueBased"
+    count_value_limit         = 5
+  })
+}
@@ -1,2 +1,8 @@
 # Alert Policy can be imported by providing the alert policy id and the team id, seperated by a comma
 terraform import atlassian-operations_alert_policy.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# Alert Policy can also be imported by the names of the team and the alert policy
+terraform import atlassian-operations_alert_policy.example "team:Payments/policy:Critical alerts"
+
+# Global alert policies can be imported by providing the global alert policy id or name
+terraform import atlassian-operations_alert_policy.global "policy:Critical alerts"
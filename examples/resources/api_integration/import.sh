@@ -1,2 +1,5 @@
 # ApiIntegration can be imported by providing the schedule id
 terraform import atlassian-operations_api_integration.example "df47a95c-f9ae-4ca6-873b-375fcad3cd18"
+
+# ApiIntegration can also be imported by its name
+terraform import atlassian-operations_api_integration.example "integration:Payments API"
@@ -1,2 +1,5 @@
 # Custom Role can be imported by providing the custom role id
 terraform import atlassian-operations_custom_role.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# Custom Role can also be imported by its name
+terraform import atlassian-operations_custom_role.example "custom-role:Responder"
@@ -1,2 +1,5 @@
 # EmailIntegration can be imported by providing the schedule id
 terraform import atlassian-operations_email_integration.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# EmailIntegration can also be imported by its name
+terraform import atlassian-operations_email_integration.example "integration:Payments email"
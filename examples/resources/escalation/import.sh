@@ -1,2 +1,5 @@
 # Escalation can be imported by providing the escalation id and the team id, seperated by a comma
 terraform import atlassian-operations_escalation.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# Escalation can also be imported by the names of the team and the escalation
+terraform import atlassian-operations_escalation.example "team:Payments/escalation:Primary"
@@ -1,2 +1,5 @@
 #!/bin/bash
-terraform import atlassian-operations_heartbeat.example heartbeat_name,team_id 
\ No newline at end of file
+terraform import atlassian-operations_heartbeat.example heartbeat_name,team_id 
+
+# Heartbeat can also be imported by the names of the team and the heartbeat
+terraform import atlassian-operations_heartbeat.example "team:Payments/heartbeat:heartbeat_name"
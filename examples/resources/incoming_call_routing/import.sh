@@ -0,0 +1,5 @@
+# Incoming call routing can be imported by providing the incoming call routing id and the team id, seperated by a comma
+terraform import atlassian-operations_incoming_call_routing.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# Incoming call routing can also be imported by the names of the team and the incoming call routing
+terraform import atlassian-operations_incoming_call_routing.example "team:Payments/incoming-call-routing:Support line"
@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "atlassian/atlassian-operations"
+    }
+  }
+}
+
+resource "atlassian-operations_incoming_call_routing" "example" {
+  team_id          = "aee7ac7d-0c6a-4a8a-a1c2-2f1e8e2a2d3b"
+  name             = "Production hotline"
+  country_code     = "US"
+  greeting_message = "You have reached the production on-call engineer. Please hold."
+  voice = {
+    language = "en-US"
+    gender   = "female"
+  }
+  recipients = [
+    {
+      id   = "b2b2ef1c-3c2f-4c47-9c3b-2e1a1a0a5e6d"
+      type = "schedule"
+    },
+    {
+      id   = "c8a1f2d0-6d5e-4b3a-8f9e-1d2c3b4a5e6f"
+      type = "escalation"
+    }
+  ]
+}
@@ -1,2 +1,5 @@
 # Integration Action can be imported by providing the notification rule id, integration-id
 terraform import atlassian-operations_integration_action.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# Integration Action can also be imported by the names of the integration and the action
+terraform import atlassian-operations_integration_action.example "integration:Payments API/action:Create alert"
@@ -1,2 +1,5 @@
 # JSM Service can be imported by providing the service id
-terraform import atlassian-operations_service.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
\ No newline at end of file
+terraform import atlassian-operations_service.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# JSM Service can also be imported by its name
+terraform import atlassian-operations_service.example "service:Payments"
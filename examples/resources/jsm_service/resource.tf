@@ -36,4 +36,29 @@ resource "atlassian-operations_service" "basic" {
       "10002"
     ]
   }
-} 
\ No newline at end of file
+} 
+
+# Compass component example, requires the provider to be configured with product_type = "compass"
+resource "atlassian-operations_service" "compass" {
+  name        = "Payments API"
+  description = "Handles card payments"
+  tier        = 1
+  type        = "SERVICE"
+  owner       = "438a6ccd-7daf-4afb-b0ee-9b440256a0a61"
+  responders = {
+    teams = [
+      "438a6ccd-7daf-4afb-b0ee-9b440256a0a61"
+    ]
+  }
+  links = [
+    {
+      type = "REPOSITORY"
+      url  = "https://bitbucket.org/example/payments-api"
+    },
+    {
+      type = "DASHBOARD"
+      url  = "https://grafana.example.com/d/payments"
+      name = "Payments dashboard"
+    }
+  ]
+}
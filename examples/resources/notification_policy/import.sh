@@ -1,2 +1,5 @@
 # Notification Policy can be imported by providing the notification policy id and the team id, seperated by a comma
 terraform import atlassian-operations_notification_policy.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# Notification Policy can also be imported by the names of the team and the notification policy
+terraform import atlassian-operations_notification_policy.example "team:Payments/policy:Business hours"
@@ -1,2 +1,5 @@
-# Team can be imported by providing the notification rule id
+# Notification rule can be imported by providing the notification rule id
 terraform import atlassian-operations_notification_rule.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# Notification rule of another user can be imported by providing the notification rule id and the account id of the user, seperated by a comma
+terraform import atlassian-operations_notification_rule.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,712020:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
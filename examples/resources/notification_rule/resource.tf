@@ -40,4 +40,27 @@ resource "atlassian-operations_notification_rule" "example" {
     loop_after = 30
     enabled    = true
   }
-}
\ No newline at end of file
+}
+
+# Notification rule of another user, requires admin rights
+resource "atlassian-operations_notification_rule" "new_engineer" {
+  user_id     = "712020:3f2e1d0c-b9a8-4765-8432-10fedcba9876"
+  name        = "New alert"
+  action_type = "create-alert"
+  enabled     = true
+
+  criteria = {
+    type = "match-all"
+  }
+
+  steps = [
+    {
+      send_after = 0
+      enabled    = true
+      contact = {
+        method = "email"
+        to     = "engineer@example.com"
+      }
+    }
+  ]
+}
@@ -0,0 +1,8 @@
+# The order of the policies of a team can be imported by providing the policy type and the team id, seperated by a comma
+terraform import atlassian-operations_policy_order.example "alert,xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# The order of the global alert policies can be imported by providing only the policy type
+terraform import atlassian-operations_policy_order.global "alert"
+
+# The team can also be referenced by its name
+terraform import atlassian-operations_policy_order.example "alert,team:Payments"
@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "atlassian/atlassian-operations"
+    }
+  }
+}
+# This example demonstrates how to pin the evaluation order of the alert policies of a team.
+# Policies that are not listed keep their position relative to each other, after the listed ones.
+resource "atlassian-operations_policy_order" "example" {
+  team_id = "team-id"
+  type    = "alert"
+  policy_ids = [
+    atlassian-operations_alert_policy.critical.id,
+    atlassian-operations_alert_policy.default.id,
+  ]
+}
+
+# Global alert policies are ordered by omitting team_id
+resource "atlassian-operations_policy_order" "global" {
+  type = "alert"
+  policy_ids = [
+    "global-policy-id-1",
+    "global-policy-id-2",
+  ]
+}
@@ -1,2 +1,5 @@
 # Team can be imported by providing the routing rule id and the team id, seperated by a comma
 terraform import atlassian-operations_routing_rule.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# Routing rule can also be imported by the names of the team and the routing rule
+terraform import atlassian-operations_routing_rule.example "team:Payments/routing-rule:Business hours"
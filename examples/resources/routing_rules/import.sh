@@ -0,0 +1,5 @@
+# The routing rules of a team can be imported by providing the team id
+terraform import atlassian-operations_routing_rules.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# The team can also be referenced by its name
+terraform import atlassian-operations_routing_rules.example "team:Payments"
@@ -0,0 +1,57 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+# Manages every routing rule of the team, in evaluation order. Rules not listed here are deleted.
+resource "atlassian-operations_routing_rules" "example" {
+  team_id = "3b7188be-91ff-40e8-8952-b0a83c7dfc58"
+
+  # The team's default rule is always evaluated last
+  default_notify = {
+    type = "none"
+  }
+
+  rules = [
+    {
+      name = "Critical alerts"
+      criteria = {
+        type = "match-all-conditions"
+        conditions = [
+          {
+            field          = "priority"
+            operation      = "equals"
+            expected_value = "P1"
+          }
+        ]
+      }
+      notify = {
+        type = "escalation"
+        id   = "f2a5b8c3-1d4e-4f6a-9b7c-8d9e0f1a2b3c"
+      }
+    },
+    {
+      name     = "Business hours"
+      timezone = "Europe/Berlin"
+      criteria = {
+        type = "match-all"
+      }
+      time_restriction = {
+        type = "time-of-day"
+        restriction = {
+          start_hour = 9
+          end_hour   = 17
+          start_min  = 0
+          end_min    = 0
+        }
+      }
+      notify = {
+        type = "schedule"
+        id   = "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+      }
+    }
+  ]
+}
@@ -1,2 +1,5 @@
 # Schedule can be imported by providing the schedule id
 terraform import atlassian-operations_schedule.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# Schedule can also be imported by its name
+terraform import atlassian-operations_schedule.example "schedule:Payments on-call"
@@ -1,2 +1,5 @@
 # Schedule Rotation can be imported by providing the rotation id and the schedule id, seperated by a comma
 terraform import atlassian-operations_schedule_rotation.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# Schedule Rotation can also be imported by the names of the schedule and the rotation
+terraform import atlassian-operations_schedule_rotation.example "schedule:Payments on-call/rotation:Weekdays"
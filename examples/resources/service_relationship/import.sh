@@ -0,0 +1,2 @@
+# Service relationship can be imported by providing the relationship id and the source service id, seperated by a comma
+terraform import atlassian-operations_service_relationship.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
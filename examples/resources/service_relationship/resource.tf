@@ -0,0 +1,14 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "atlassian/atlassian-operations"
+    }
+  }
+}
+
+# The checkout service depends on the payments service
+resource "atlassian-operations_service_relationship" "checkout_depends_on_payments" {
+  source_service_id = atlassian-operations_service.checkout.id
+  target_service_id = atlassian-operations_service.payments.id
+  type              = "DEPENDS_ON"
+}
@@ -1,2 +1,5 @@
 # Team can be imported by providing the team id and the organization id, seperated by a comma
 terraform import atlassian-operations_team.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# The team can also be referenced by its name
+terraform import atlassian-operations_team.example "team:Payments,xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
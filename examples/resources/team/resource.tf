@@ -16,4 +16,8 @@ resource "atlassian-operations_team" "example" {
       account_id = "XXXXXX:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
     }
   ]
+
+  timeouts {
+    create = "30m"
+  }
 }
@@ -0,0 +1,5 @@
+# Team member role can be imported by providing the team id and the account id of the member, seperated by a comma
+terraform import atlassian-operations_team_member_role.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,712020:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# The team can also be referenced by its name
+terraform import atlassian-operations_team_member_role.example "team:Payments,712020:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "atlassian/atlassian-operations"
+    }
+  }
+}
+
+# Grant a team member a custom team role
+resource "atlassian-operations_team_member_role" "on_call_admin" {
+  team_id    = "aee7ac7d-0c6a-4a8a-a1c2-2f1e8e2a2d3b"
+  account_id = "712020:3f2e1d0c-b9a8-4765-8432-10fedcba9876"
+  role       = atlassian-operations_team_role.on_call_admin.name
+}
+
+# Grant a team member the built-in admin role
+resource "atlassian-operations_team_member_role" "team_admin" {
+  team_id    = "aee7ac7d-0c6a-4a8a-a1c2-2f1e8e2a2d3b"
+  account_id = "712020:0a1b2c3d-4e5f-6789-abcd-ef0123456789"
+  role       = "admin"
+}
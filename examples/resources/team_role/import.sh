@@ -0,0 +1,5 @@
+# Team role can be imported by providing the team role id and the team id, seperated by a comma
+terraform import atlassian-operations_team_role.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# Team role can also be imported by the names of the team and the team role
+terraform import atlassian-operations_team_role.example "team:Payments/role:Incident commander"
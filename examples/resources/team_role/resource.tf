@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "atlassian/atlassian-operations"
+    }
+  }
+}
+
+resource "atlassian-operations_team_role" "on_call_admin" {
+  team_id = "aee7ac7d-0c6a-4a8a-a1c2-2f1e8e2a2d3b"
+  name    = "On-call admin"
+  granted_rights = [
+    "alert-acknowledge",
+    "alert-add-note",
+    "alert-close",
+  ]
+  disallowed_rights = [
+    "alert-delete",
+  ]
+}
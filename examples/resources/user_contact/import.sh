@@ -1,2 +1,5 @@
 # User Contact can be imported by providing the user contact id
 terraform import atlassian-operations_user_contact.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+# User Contact of another user can be imported by providing the user contact id and the account id of the user, seperated by a comma
+terraform import atlassian-operations_user_contact.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,712020:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
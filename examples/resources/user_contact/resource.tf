@@ -17,4 +17,12 @@ resource "atlassian-operations_user_contact" "voice_contact" {
   method  = "voice"
   to      = "49-5360287176"  # Phone number
   enabled = false  # Contact is created but disabled
-}
\ No newline at end of file
+}
+
+# Example: Create an SMS contact for another user, requires admin rights
+resource "atlassian-operations_user_contact" "new_engineer_sms" {
+  user_id = "712020:3f2e1d0c-b9a8-4765-8432-10fedcba9876"
+  method  = "sms"
+  to      = "+1 (555) 010-0000"
+  enabled = true
+}
@@ -0,0 +1,2 @@
+# Forwarding rule can be imported by providing the forwarding rule id
+terraform import atlassian-operations_user_forwarding_rule.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
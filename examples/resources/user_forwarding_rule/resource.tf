@@ -0,0 +1,15 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "atlassian/atlassian-operations"
+    }
+  }
+}
+
+# Forward notifications to a colleague during a vacation
+resource "atlassian-operations_user_forwarding_rule" "vacation_cover" {
+  from_user_id = "712020:3f2e1d0c-b9a8-4765-8432-10fedcba9876"
+  to_user_id   = "712020:0a1b2c3d-4e5f-6789-abcd-ef0123456789"
+  start_date   = "2025-07-01T00:00:00Z"
+  end_date     = "2025-07-15T00:00:00Z"
+}
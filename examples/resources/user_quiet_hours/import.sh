@@ -0,0 +1,2 @@
+# Quiet hours belong to the authenticated user and can be imported with any identifier, e.g. the account id of the user
+terraform import atlassian-operations_user_quiet_hours.example "712020:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
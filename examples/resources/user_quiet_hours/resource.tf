@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "atlassian/atlassian-operations"
+    }
+  }
+}
+
+# No notifications on weeknights and during the weekend
+resource "atlassian-operations_user_quiet_hours" "example" {
+  time_restriction = {
+    type = "weekday-and-time-of-day"
+    restrictions = [
+      {
+        start_day  = "monday"
+        end_day    = "friday"
+        start_hour = 22
+        end_hour   = 7
+        start_min  = 0
+        end_min    = 0
+      },
+      {
+        start_day  = "saturday"
+        end_day    = "monday"
+        start_hour = 0
+        end_hour   = 7
+        start_min  = 0
+        end_min    = 0
+      }
+    ]
+  }
+}
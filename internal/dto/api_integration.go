@@ -14,4 +14,7 @@ type (
 		Domains                []string               `json:"domains,omitempty"`
 		TypeSpecificProperties map[string]interface{} `json:"typeSpecificProperties"`
 	}
+	ApiIntegrationApiKey struct {
+		ApiKey string `json:"apiKey"`
+	}
 )
@@ -1,5 +1,40 @@
 package dto
 
+// CustomRoleRights is the catalog of rights that can be granted to or disallowed for a custom role
+var CustomRoleRights = []string{
+	"alert-acknowledge",
+	"alert-action",
+	"alert-add-attachment",
+	"alert-add-note",
+	"alert-add-responder",
+	"alert-assign-ownership",
+	"alert-close",
+	"alert-create",
+	"alert-custom-action",
+	"alert-delete",
+	"alert-delete-attachment",
+	"alert-escalate",
+	"alert-snooze",
+	"alert-take-ownership",
+	"alert-unacknowledge",
+	"alert-update-description",
+	"alert-update-details",
+	"alert-update-message",
+	"alert-update-priority",
+	"alert-update-tags",
+	"alert-view",
+	"alerts-access-all",
+	"configurations-read-only",
+	"contacts-edit",
+	"logs-page-access",
+	"maintenance-edit",
+	"notification-rules-edit",
+	"profile-edit",
+	"quiet-hours-edit",
+	"reports-access",
+	"who-is-on-call-show-all",
+}
+
 type CustomRoleDto struct {
 	ID               string   `json:"id,omitempty"`
 	Name             string   `json:"name"`
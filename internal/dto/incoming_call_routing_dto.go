@@ -0,0 +1,17 @@
+package dto
+
+type IncomingCallRoutingDto struct {
+	ID              string                    `json:"id,omitempty"`
+	Name            string                    `json:"name"`
+	CountryCode     string                    `json:"countryCode"`
+	PhoneNumber     string                    `json:"phoneNumber,omitempty"`
+	GreetingMessage string                    `json:"greetingMessage,omitempty"`
+	Voice           *IncomingCallRoutingVoice `json:"voice,omitempty"`
+	Recipients      []ResponderInfo           `json:"recipients"`
+	Enabled         bool                      `json:"enabled"`
+}
+
+type IncomingCallRoutingVoice struct {
+	Language string `json:"language"`
+	Gender   string `json:"gender"`
+}
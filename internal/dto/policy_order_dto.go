@@ -0,0 +1,7 @@
+package dto
+
+type (
+	PolicyChangeOrderDto struct {
+		TargetIndex int `json:"targetIndex"`
+	}
+)
@@ -1,5 +1,11 @@
 package dto
 
+var (
+	JsmServiceTypes       = []string{"SOFTWARE_SERVICES", "BUSINESS_SERVICES", "CAPABILITIES_SERVICES", "APPLICATIONS"}
+	CompassComponentTypes = []string{"SERVICE", "APPLICATION", "LIBRARY", "CAPABILITY", "CLOUD_RESOURCE", "DATA_PIPELINE", "MACHINE_LEARNING_MODEL", "UI_ELEMENT", "WEBSITE", "OTHER"}
+	CompassLinkTypes      = []string{"DOCUMENT", "CHAT_CHANNEL", "REPOSITORY", "PROJECT", "DASHBOARD", "ON_CALL", "OTHER_LINK"}
+)
+
 type ServiceDto struct {
 	ID              string              `json:"id,omitempty"`
 	Name            string              `json:"name"`
@@ -11,6 +17,7 @@ type ServiceDto struct {
 	Responders      *RespondersDto      `json:"responders,omitempty"`
 	Stakeholders    *StakeholdersDto    `json:"stakeholders,omitempty"`
 	Projects        *ProjectsDto        `json:"projects,omitempty"`
+	Links           []ServiceLinkDto    `json:"links,omitempty"`
 }
 
 type ChangeApproversDto struct {
@@ -29,3 +36,9 @@ type StakeholdersDto struct {
 type ProjectsDto struct {
 	IDs []string `json:"ids,omitempty"`
 }
+
+type ServiceLinkDto struct {
+	Type string `json:"type"`
+	Url  string `json:"url"`
+	Name string `json:"name,omitempty"`
+}
@@ -0,0 +1,8 @@
+package dto
+
+type ServiceRelationshipDto struct {
+	ID              string `json:"id,omitempty"`
+	SourceServiceId string `json:"sourceServiceId,omitempty"`
+	TargetServiceId string `json:"targetServiceId"`
+	Type            string `json:"type"`
+}
@@ -29,6 +29,10 @@ type (
 	TeamMemberList struct {
 		Members []TeamMember `json:"members"`
 	}
+	OpsTeamDto struct {
+		TeamId   string `json:"teamId"`
+		TeamName string `json:"teamName"`
+	}
 	TeamEnableOps struct {
 		TeamId          string   `json:"platformTeamId"`
 		AdminAccountIds []string `json:"adminAccountIds"`
@@ -0,0 +1,13 @@
+package dto
+
+type TeamRoleDto struct {
+	ID               string   `json:"id,omitempty"`
+	Name             string   `json:"name"`
+	GrantedRights    []string `json:"grantedRights"`
+	DisallowedRights []string `json:"disallowedRights"`
+}
+
+type TeamMemberRoleDto struct {
+	AccountId string `json:"accountId,omitempty"`
+	Role      string `json:"role"`
+}
@@ -0,0 +1,15 @@
+package dto
+
+type UserQuietHoursDto struct {
+	UserId          string           `json:"userId,omitempty"`
+	Enabled         bool             `json:"enabled"`
+	TimeRestriction *TimeRestriction `json:"timeRestriction"`
+}
+
+type ForwardingRuleDto struct {
+	ID        string `json:"id,omitempty"`
+	FromUser  string `json:"fromUser"`
+	ToUser    string `json:"toUser"`
+	StartDate string `json:"startDate"`
+	EndDate   string `json:"endDate"`
+}
@@ -1,13 +1,14 @@
 package httpClientHelpers
 
 import (
+	"context"
 	"fmt"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
 )
 
-func GenerateJsmOpsClientRequest(providerModel dto.AtlassianOpsProviderModel) *httpClient.Request {
-	req := httpClient.NewRequest()
+func GenerateJsmOpsClientRequest(ctx context.Context, providerModel dto.AtlassianOpsProviderModel) *httpClient.Request {
+	req := httpClient.NewRequest().SetContext(ctx)
 
 	switch providerModel.GetProductType() {
 	case "jira-service-desk":
@@ -23,8 +24,8 @@ func GenerateJsmOpsClientRequest(providerModel dto.AtlassianOpsProviderModel) *h
 	return req
 }
 
-func GenerateTeamsClientRequest(providerModel dto.AtlassianOpsProviderModel) *httpClient.Request {
-	req := httpClient.NewRequest()
+func GenerateTeamsClientRequest(ctx context.Context, providerModel dto.AtlassianOpsProviderModel) *httpClient.Request {
+	req := httpClient.NewRequest().SetContext(ctx)
 	req.SetUrl(fmt.Sprintf("https://%s/gateway/api/public/teams/v1/org/", providerModel.GetDomainName()))
 	req.SetRetryCount(providerModel.GetApiRetryCount())
 	req.SetRetryWaitTime(providerModel.GetApiRetryWait())
@@ -33,9 +34,15 @@ func GenerateTeamsClientRequest(providerModel dto.AtlassianOpsProviderModel) *ht
 	return req
 }
 
-func GenerateServiceClientRequest(providerModel dto.AtlassianOpsProviderModel) *httpClient.Request {
-	req := httpClient.NewRequest()
-	req.SetUrl(fmt.Sprintf("%s/jsm/api/%s", getAtlassianApiDomain(providerModel.GetIsStaging()), providerModel.GetCloudId()))
+func GenerateServiceClientRequest(ctx context.Context, providerModel dto.AtlassianOpsProviderModel) *httpClient.Request {
+	req := httpClient.NewRequest().SetContext(ctx)
+	switch providerModel.GetProductType() {
+	case "compass":
+		req.SetUrl(fmt.Sprintf("%s/compass/cloud/%s/ops", getAtlassianApiDomain(providerModel.GetIsStaging()), providerModel.GetCloudId()))
+	default:
+		req.SetUrl(fmt.Sprintf("%s/jsm/api/%s", getAtlassianApiDomain(providerModel.GetIsStaging()), providerModel.GetCloudId()))
+	}
+
 	req.SetRetryCount(providerModel.GetApiRetryCount())
 	req.SetRetryWaitTime(providerModel.GetApiRetryWait())
 	req.SetRetryMaxWaitTime(providerModel.GetApiRetryWaitMax())
@@ -43,8 +50,8 @@ func GenerateServiceClientRequest(providerModel dto.AtlassianOpsProviderModel) *
 	return req
 }
 
-func GenerateUserClientRequest(providerModel dto.AtlassianOpsProviderModel) *httpClient.Request {
-	req := httpClient.NewRequest()
+func GenerateUserClientRequest(ctx context.Context, providerModel dto.AtlassianOpsProviderModel) *httpClient.Request {
+	req := httpClient.NewRequest().SetContext(ctx)
 	switch providerModel.GetProductType() {
 	case "jira-service-desk":
 		req.SetUrl(fmt.Sprintf("https://%s/rest/api/3/user/", providerModel.GetDomainName()))
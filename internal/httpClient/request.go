@@ -88,6 +88,10 @@ func (receiver *Request) AddRetryCondition(condition RetryConditionFunc) *Reques
 }
 
 func (receiver *Request) shouldRetryBecauseCondition(ctx context.Context, resp *Response, err error) (bool, error) {
+	// Never retry once the request context is done, whatever the retry conditions say
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
 	shouldRetry, _ := retryablehttp.DefaultRetryPolicy(ctx, resp.nativeResponse, err)
 	if !shouldRetry {
 		for _, fun := range receiver.retryConditions {
@@ -105,6 +109,11 @@ func (receiver *Request) shouldRetryBecauseCondition(ctx context.Context, resp *
 	return shouldRetry, err
 }
 
+func (r *Request) SetContext(ctx context.Context) *Request {
+	r.innerRequest = r.innerRequest.WithContext(ctx)
+	return r
+}
+
 func (r *Request) SetBasicAuth(username, password string) *Request {
 	r.innerRequest.SetBasicAuth(username, password)
 	return r
@@ -13,14 +13,19 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource                = &AlertPolicyResource{}
-	_ resource.ResourceWithConfigure   = &AlertPolicyResource{}
-	_ resource.ResourceWithImportState = &AlertPolicyResource{}
+	_ resource.Resource                   = &AlertPolicyResource{}
+	_ resource.ResourceWithConfigure      = &AlertPolicyResource{}
+	_ resource.ResourceWithImportState    = &AlertPolicyResource{}
+	_ resource.ResourceWithIdentity       = &AlertPolicyResource{}
+	_ resource.ResourceWithUpgradeState   = &AlertPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &AlertPolicyResource{}
 )
 
 type AlertPolicyResource struct {
@@ -35,12 +40,26 @@ func (r *AlertPolicyResource) Metadata(_ context.Context, req resource.MetadataR
 	resp.TypeName = req.ProviderTypeName + "_alert_policy"
 }
 
-func (r *AlertPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *AlertPolicyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:    0,
 		Attributes: schemaAttributes.AlertPolicyResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
+func (r *AlertPolicyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.AlertPolicyResourceIdentityAttributes,
+	}
+}
+
+func (r *AlertPolicyResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r)
+}
+
 func (r *AlertPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring AlertPolicyResource")
 
@@ -61,6 +80,38 @@ func (r *AlertPolicyResource) Configure(ctx context.Context, req resource.Config
 	tflog.Trace(ctx, "Configured AlertPolicyResource")
 }
 
+func (r *AlertPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data dataModels.AlertPolicyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.TimeRestriction.IsNull() || data.TimeRestriction.IsUnknown() {
+		return
+	}
+
+	var timeRestriction dataModels.AlertTimeRestrictionModel
+	resp.Diagnostics.Append(data.TimeRestriction.As(ctx, &timeRestriction, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() || timeRestriction.TimeRestrictions.IsUnknown() {
+		return
+	}
+
+	var periods []dataModels.AlertTimeRestrictionPeriodModel
+	resp.Diagnostics.Append(timeRestriction.TimeRestrictions.ElementsAs(ctx, &periods, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if timeRestriction.Enabled.ValueBool() && len(periods) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("time_restriction").AtName("time_restrictions"),
+			"Invalid Time Restriction",
+			"At least one time restriction period must be set when time_restriction is enabled.",
+		)
+	}
+	for i, period := range periods {
+		validatePolicyTimeRestrictionPeriod(period.StartHour, period.StartMinute, period.EndHour, period.EndMinute,
+			path.Root("time_restriction").AtName("time_restrictions").AtListIndex(i), &resp.Diagnostics)
+	}
+}
+
 func (r *AlertPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Trace(ctx, "Creating AlertPolicyResource")
 
@@ -70,6 +121,9 @@ func (r *AlertPolicyResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Convert to DTO
 	alertPolicyDto, _ := AlertPolicyModelToDto(ctx, &data)
 
@@ -82,7 +136,7 @@ func (r *AlertPolicyResource) Create(ctx context.Context, req resource.CreateReq
 
 	// Create alert policy
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(createBaseUrl).
 		Method(httpClient.POST).
 		SetBody(alertPolicyDto).
@@ -117,16 +171,22 @@ func (r *AlertPolicyResource) Create(ctx context.Context, req resource.CreateReq
 	order := getAlertPolicyOrder(ctx, r.clientConfiguration, data.TeamID.ValueString(), alertPolicyDto.ID)
 	// Update state with response
 	result, _ := AlertPolicyDtoToModel(ctx, order, alertPolicyDto)
+	result.Timeouts = data.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *AlertPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data dataModels.AlertPolicyModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Trace(ctx, "Reading AlertPolicyResource")
 
 	var alertPolicyDto dto.AlertPolicyDto
@@ -139,7 +199,7 @@ func (r *AlertPolicyResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(readBaseUrl).
 		Method(httpClient.GET).
 		SetBodyParseObject(&alertPolicyDto).
@@ -179,6 +239,7 @@ func (r *AlertPolicyResource) Read(ctx context.Context, req resource.ReadRequest
 	order := getAlertPolicyOrder(ctx, r.clientConfiguration, data.TeamID.ValueString(), alertPolicyDto.ID)
 
 	result, _ := AlertPolicyDtoToModel(ctx, order, &alertPolicyDto)
+	result.Timeouts = data.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
 }
 
@@ -189,6 +250,9 @@ func (r *AlertPolicyResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Convert to DTO
 	alertPolicyDto, _ := AlertPolicyModelToDto(ctx, &data)
 
@@ -201,7 +265,7 @@ func (r *AlertPolicyResource) Update(ctx context.Context, req resource.UpdateReq
 
 	// Update alert policy
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(updateBaseUrl).
 		Method(httpClient.PUT).
 		SetBody(alertPolicyDto).
@@ -234,7 +298,9 @@ func (r *AlertPolicyResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 	order := getAlertPolicyOrder(ctx, r.clientConfiguration, data.TeamID.ValueString(), alertPolicyDto.ID)
 	result, _ := AlertPolicyDtoToModel(ctx, order, alertPolicyDto)
+	result.Timeouts = data.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *AlertPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -244,6 +310,9 @@ func (r *AlertPolicyResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var deleteBaseUrl string
 	if data.TeamID.IsUnknown() || data.TeamID.IsNull() {
 		deleteBaseUrl = fmt.Sprintf("/v1/alerts/policies/%s", data.ID.ValueString())
@@ -252,7 +321,7 @@ func (r *AlertPolicyResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(deleteBaseUrl).
 		Method(httpClient.DELETE).
 		Send()
@@ -284,11 +353,33 @@ func (r *AlertPolicyResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *AlertPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		return
+	}
+
+	nameAndId := func(policy dto.BaseAlertPolicyDto) (string, string) {
+		return policy.Name, policy.ID
+	}
+	if names, ok := parseImportReference(req.ID, "policy"); ok {
+		id, err := findIdByName(ctx, r.clientConfiguration, policiesBaseUrl(""), map[string]string{"type": "alert"}, "alert policy", names[0], nameAndId)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Resolve Import Identifier", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+	if _, ok := parseImportReference(req.ID, "team", "policy"); ok {
+		importTeamScopedResource(ctx, r.clientConfiguration, req, resp, "policy", policiesBaseUrl, map[string]string{"type": "alert"}, nameAndId)
+		return
+	}
+
 	idParts := strings.Split(req.ID, ",")
 	if len(idParts) > 2 || idParts[0] == "" || (len(idParts) == 2 && idParts[1] == "") {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: id,team_id (for team policies); or: id (for global policies). Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: id,team_id or team:<team name>/policy:<name> (for team policies); or: id or policy:<name> (for global policies). Got: %q", req.ID),
 		)
 		return
 	}
@@ -310,7 +401,7 @@ func getAlertPolicyOrder(ctx context.Context, configuration dto.AtlassianOpsProv
 
 	for !doneLooping {
 		req := httpClientHelpers.
-			GenerateJsmOpsClientRequest(configuration).
+			GenerateJsmOpsClientRequest(ctx, configuration).
 			JoinBaseUrl(baseURL).
 			Method(httpClient.GET).
 			SetBodyParseObject(&listAlertPoliciesResponse).
@@ -3,6 +3,7 @@ package provider
 import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/google/uuid"
@@ -478,3 +479,54 @@ resource "atlassian-operations_alert_policy" "test" {
 		},
 	})
 }
+
+func TestAccAlertPolicyResource_InvalidTimeRestriction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_alert_policy" "test" {
+  name    = "alert policy"
+  team_id = "00000000-0000-0000-0000-000000000000"
+  type    = "alert"
+  enabled = true
+  message = "Test alert message"
+
+  time_restriction = {
+    enabled = true
+    time_restrictions = []
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Time Restriction"),
+			},
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_alert_policy" "test" {
+  name    = "alert policy"
+  team_id = "00000000-0000-0000-0000-000000000000"
+  type    = "alert"
+  enabled = true
+  message = "Test alert message"
+
+  time_restriction = {
+    enabled = true
+    time_restrictions = [
+      {
+        start_hour   = 9
+        start_minute = 0
+        end_hour     = 9
+        end_minute   = 0
+      }
+    ]
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Time Restriction Period"),
+			},
+		},
+	})
+}
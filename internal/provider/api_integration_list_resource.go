@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &ApiIntegrationListResource{}
+	_ list.ListResourceWithConfigure = &ApiIntegrationListResource{}
+)
+
+func NewApiIntegrationListResource() list.ListResource {
+	return &ApiIntegrationListResource{}
+}
+
+// ApiIntegrationListResource defines the list resource implementation.
+type ApiIntegrationListResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (r *ApiIntegrationListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_integration"
+}
+
+func (r *ApiIntegrationListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the API-based integrations of the site.",
+	}
+}
+
+func (r *ApiIntegrationListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring ApiIntegrationListResource")
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Unexpected List Resource Configure Type")
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+
+	tflog.Trace(ctx, "Configured ApiIntegrationListResource")
+}
+
+func (r *ApiIntegrationListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Trace(ctx, "Listing the ApiIntegrationListResource")
+
+	stream.Results = listResults(ctx, r.clientConfiguration, req, NewApiIntegrationResource, "/v1/integrations", nil, "integration", func(integration dto.ApiIntegration) (string, map[string]string, bool) {
+		return integration.Name, map[string]string{"id": integration.Id}, integration.Type != "Email"
+	})
+}
@@ -13,13 +13,19 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ApiIntegrationResource{}
 var _ resource.ResourceWithImportState = &ApiIntegrationResource{}
+var _ resource.ResourceWithIdentity = &ApiIntegrationResource{}
+var _ resource.ResourceWithModifyPlan = &ApiIntegrationResource{}
+var _ resource.ResourceWithMoveState = &ApiIntegrationResource{}
+var _ resource.ResourceWithUpgradeState = &ApiIntegrationResource{}
 
 func NewApiIntegrationResource() resource.Resource {
 	return &ApiIntegrationResource{}
@@ -34,9 +40,29 @@ func (r *ApiIntegrationResource) Metadata(_ context.Context, req resource.Metada
 	resp.TypeName = req.ProviderTypeName + "_api_integration"
 }
 
-func (r *ApiIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ApiIntegrationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:    1,
 		Attributes: schemaAttributes.ApiIntegrationResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *ApiIntegrationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.ApiIntegrationResourceIdentityAttributes,
+	}
+}
+
+func (r *ApiIntegrationResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r, addDeletionProtection)
+}
+
+func (r *ApiIntegrationResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		integrationStateMover(ctx, NewEmailIntegrationResource(), "atlassian-operations_email_integration", moveEmailIntegrationToApiIntegration),
 	}
 }
 
@@ -71,10 +97,14 @@ func (r *ApiIntegrationResource) Create(ctx context.Context, req resource.Create
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	dtoObj := ApiIntegrationModelToDto(ctx, data)
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl("v1/integrations").
 		Method(httpClient.POST).
 		SetBody(dtoObj).
@@ -106,7 +136,7 @@ func (r *ApiIntegrationResource) Create(ctx context.Context, req resource.Create
 
 	if data.DeleteDefaultActions.ValueBool() {
 		// List default actions using the API Integration ID then using delete action endpoint delete each action
-		err = listDefaultActionsAndDelete(r.clientConfiguration, dtoObj.Id)
+		err = listDefaultActionsAndDelete(ctx, r.clientConfiguration, dtoObj.Id)
 		if err != nil {
 			tflog.Warn(ctx, fmt.Sprintf("Error deleting default actions for API integration: %s", err))
 			resp.Diagnostics.AddWarning("Error Deleting Default Actions", fmt.Sprintf("Unable to delete default actions for API integration: %s", err))
@@ -118,14 +148,16 @@ func (r *ApiIntegrationResource) Create(ctx context.Context, req resource.Create
 	tflog.Trace(ctx, "Created the ApiIntegrationResource")
 
 	// Save data into Terraform state
+	data.Timeouts = timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 	tflog.Trace(ctx, "Saved the ApiIntegrationResource into Terraform state")
 }
 
-func listDefaultActionsAndDelete(configuration dto.AtlassianOpsProviderModel, integrationId string) error {
+func listDefaultActionsAndDelete(ctx context.Context, configuration dto.AtlassianOpsProviderModel, integrationId string) error {
 	defaultActions := dto.IntegrationActionListDto{}
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(configuration).
+		GenerateJsmOpsClientRequest(ctx, configuration).
 		JoinBaseUrl(fmt.Sprintf("v1/integrations/%s/actions", integrationId)).
 		Method(httpClient.GET).
 		SetBodyParseObject(&defaultActions).
@@ -138,7 +170,7 @@ func listDefaultActionsAndDelete(configuration dto.AtlassianOpsProviderModel, in
 	}
 	for _, action := range defaultActions.Values {
 		httpResp, err := httpClientHelpers.
-			GenerateJsmOpsClientRequest(configuration).
+			GenerateJsmOpsClientRequest(ctx, configuration).
 			JoinBaseUrl(fmt.Sprintf("v1/integrations/%s/actions/%s", integrationId, action.ID)).
 			Method(httpClient.DELETE).
 			Send()
@@ -152,17 +184,45 @@ func listDefaultActionsAndDelete(configuration dto.AtlassianOpsProviderModel, in
 	return nil
 }
 
+func resetIntegrationApiKey(ctx context.Context, configuration dto.AtlassianOpsProviderModel, integrationId string) (string, error) {
+	apiKey := dto.ApiIntegrationApiKey{}
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(ctx, configuration).
+		JoinBaseUrl(fmt.Sprintf("v1/integrations/%s/reset-api-key", integrationId)).
+		Method(httpClient.POST).
+		SetBodyParseObject(&apiKey).
+		Send()
+	if httpResp == nil {
+		return "", fmt.Errorf("unable to reset api key of integration %s, got nil response", integrationId)
+	}
+	if httpResp.IsError() {
+		if errorResponse := httpResp.GetErrorBody(); errorResponse != nil {
+			return "", fmt.Errorf("unable to reset api key of integration %s, status code: %d. Got response: %s", integrationId, httpResp.GetStatusCode(), *errorResponse)
+		}
+		return "", fmt.Errorf("unable to reset api key of integration %s, got http response: %d", integrationId, httpResp.GetStatusCode())
+	}
+	if err != nil {
+		return "", fmt.Errorf("unable to reset api key of integration %s, got error: %s", integrationId, err)
+	}
+	return apiKey.ApiKey, nil
+}
+
 func (r *ApiIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data dataModels.ApiIntegrationModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
+
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Read, &resp.Diagnostics)
+	defer cancel()
 
 	tflog.Trace(ctx, "Reading the ApiIntegrationResource")
 
 	ApiIntegration := dto.ApiIntegration{}
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("v1/integrations/%s", data.Id.ValueString())).
 		Method(httpClient.GET).
 		SetBodyParseObject(&ApiIntegration).
@@ -196,25 +256,32 @@ func (r *ApiIntegrationResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	data = ApiIntegrationDtoToModel(ApiIntegration, data)
+	data.DeletionProtection = deletionProtectionOrDefault(data.DeletionProtection)
 
 	tflog.Trace(ctx, "Read the ApiIntegrationResource")
 
+	data.Timeouts = timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	tflog.Trace(ctx, "Saved the ApiIntegrationResource into Terraform state")
 }
 
 func (r *ApiIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data dataModels.ApiIntegrationModel
+	var data, stateData dataModels.ApiIntegrationModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Update, &resp.Diagnostics)
+	defer cancel()
 
 	tflog.Trace(ctx, "Updating the ApiIntegrationResource")
 
 	dtoObj := ApiIntegrationModelToDto(ctx, data)
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("v1/integrations/%s", data.Id.ValueString())).
 		Method(httpClient.PATCH).
 		SetBody(dtoObj).
@@ -246,9 +313,21 @@ func (r *ApiIntegrationResource) Update(ctx context.Context, req resource.Update
 
 	data = ApiIntegrationDtoToModel(dtoObj, data)
 
+	if !data.RotateKeyTrigger.Equal(stateData.RotateKeyTrigger) {
+		apiKey, err := resetIntegrationApiKey(ctx, r.clientConfiguration, data.Id.ValueString())
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. %s", err))
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		data.ApiKey = types.StringValue(apiKey)
+	}
+
 	tflog.Trace(ctx, "Updated the ApiIntegrationResource")
 
+	data.Timeouts = timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 	tflog.Trace(ctx, "Saved the ApiIntegrationResource into Terraform state")
 }
 
@@ -258,10 +337,18 @@ func (r *ApiIntegrationResource) Delete(ctx context.Context, req resource.Delete
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	checkDeletionProtection(data.DeletionProtection, "api integration", data.Id.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Trace(ctx, "Deleting the ApiIntegrationResource")
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("v1/integrations/%s", data.Id.ValueString())).
 		Method(httpClient.DELETE).
 		Send()
@@ -291,6 +378,36 @@ func (r *ApiIntegrationResource) Delete(ctx context.Context, req resource.Delete
 	tflog.Trace(ctx, "Deleted the ApiIntegrationResource")
 }
 
+func (r *ApiIntegrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkReplacementProtection(ctx, req, resp, "api integration", path.Root("type"), path.Root("team_id"))
+
+	// Nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state dataModels.ApiIntegrationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A changed trigger resets the key in Update, so the stored key can't be kept in the plan
+	if !plan.RotateKeyTrigger.Equal(state.RotateKeyTrigger) {
+		tflog.Trace(ctx, "rotate_key_trigger changed, marking api_key as unknown")
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("api_key"), types.StringUnknown())...)
+	}
+}
+
 func (r *ApiIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		return
+	}
+
+	importNamedResource(ctx, req, resp, "integration", func(name string) (string, error) {
+		return resolveIntegrationId(ctx, r.clientConfiguration, name)
+	})
 }
@@ -1,12 +1,18 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/google/uuid"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -85,7 +91,7 @@ resource "atlassian-operations_api_integration" "example" {
 				ResourceName:            "atlassian-operations_api_integration.example",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"type_specific_properties", "directions", "domains", "api_key", "delete_default_actions"},
+				ImportStateVerifyIgnore: []string{"type_specific_properties", "directions", "domains", "api_key", "rotate_key_trigger", "delete_default_actions"},
 			},
 			// Update and Read testing
 			{
@@ -226,7 +232,7 @@ resource "atlassian-operations_api_integration" "example" {
 				ResourceName:            "atlassian-operations_api_integration.example",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"type_specific_properties", "directions", "domains", "api_key", "delete_default_actions"},
+				ImportStateVerifyIgnore: []string{"type_specific_properties", "directions", "domains", "api_key", "rotate_key_trigger", "delete_default_actions"},
 			},
 			// Update and Read testing
 			{
@@ -291,3 +297,150 @@ resource "atlassian-operations_api_integration" "example" {
 		},
 	})
 }
+
+func TestAccApiIntegrationResource_RotateKey(t *testing.T) {
+	apiIntegrationName := uuid.NewString()
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	apiPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	config := func(trigger string) string {
+		return providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + apiPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  display_name = "` + teamName + `"
+  description = "team description"
+  organization_id = "` + organizationId + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+       account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_api_integration" "example" {
+  name               = "` + apiIntegrationName + `"
+  team_id            = atlassian-operations_team.example.id
+  type               = "API"
+  enabled            = true
+  rotate_key_trigger = "` + trigger + `"
+}
+`
+	}
+
+	var firstApiKey string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if apiPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				ExpectNonEmptyPlan: true,
+				Config:             config("first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_api_integration.example", "rotate_key_trigger", "first"),
+					resource.TestCheckResourceAttrWith("atlassian-operations_api_integration.example", "api_key", func(value string) error {
+						if value == "" {
+							return fmt.Errorf("expected api_key to be set")
+						}
+						firstApiKey = value
+						return nil
+					}),
+				),
+			},
+			// Rotate the key by changing the trigger
+			{
+				ExpectNonEmptyPlan: true,
+				Config:             config("second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_api_integration.example", "name", apiIntegrationName),
+					resource.TestCheckResourceAttr("atlassian-operations_api_integration.example", "rotate_key_trigger", "second"),
+					resource.TestCheckResourceAttrWith("atlassian-operations_api_integration.example", "api_key", func(value string) error {
+						if value == "" || value == firstApiKey {
+							return fmt.Errorf("expected api_key to be rotated")
+						}
+						return nil
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// TestApiIntegrationModifyPlan_RotateKeyTrigger checks that the key reset by a changed rotate_key_trigger is planned
+// as a new api_key, so Update can store it in the state.
+func TestApiIntegrationModifyPlan_RotateKeyTrigger(t *testing.T) {
+	ctx := context.Background()
+	res := NewApiIntegrationResource()
+	state := currentStateFixture(t, ctx, res, "api_integration")
+
+	tests := map[string]struct {
+		rotateKeyTrigger tftypes.Value
+		expectedApiKey   types.String
+	}{
+		"unchanged trigger": {
+			rotateKeyTrigger: tftypes.NewValue(tftypes.String, "example-rotate_key_trigger"),
+			expectedApiKey:   types.StringValue("example-api_key"),
+		},
+		"changed trigger": {
+			rotateKeyTrigger: tftypes.NewValue(tftypes.String, "rotated"),
+			expectedApiKey:   types.StringUnknown(),
+		},
+		"removed trigger": {
+			rotateKeyTrigger: tftypes.NewValue(tftypes.String, nil),
+			expectedApiKey:   types.StringUnknown(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := fwresource.ModifyPlanRequest{
+				State: state,
+				Plan:  tfsdk.Plan{Schema: state.Schema, Raw: withStateAttribute(t, state.Raw, "rotate_key_trigger", test.rotateKeyTrigger)},
+			}
+			resp := fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+			res.(fwresource.ResourceWithModifyPlan).ModifyPlan(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var apiKey types.String
+			resp.Plan.GetAttribute(ctx, path.Root("api_key"), &apiKey)
+			if !apiKey.Equal(test.expectedApiKey) {
+				t.Errorf("expected api_key %s, got %s", test.expectedApiKey, apiKey)
+			}
+		})
+	}
+}
+
+// withStateAttribute returns the state or plan value with its top level attribute name set to value.
+func withStateAttribute(t *testing.T, raw tftypes.Value, name string, value tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	transformed, err := tftypes.Transform(raw, func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if p.Equal(tftypes.NewAttributePath().WithAttributeName(name)) {
+			return value, nil
+		}
+		return v, nil
+	})
+	if err != nil {
+		t.Fatalf("unable to set %s: %s", name, err)
+	}
+	return transformed
+}
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &BusinessHoursFunction{}
+
+// Days of the week in the order ranges such as mon-fri are expanded in
+var businessHoursWeekdays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+type BusinessHoursFunction struct{}
+
+func NewBusinessHoursFunction() function.Function {
+	return &BusinessHoursFunction{}
+}
+
+func (f *BusinessHoursFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "business_hours"
+}
+
+func (f *BusinessHoursFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds a time_restriction for a daily time window on the given days",
+		Description: "Returns a time_restriction object for routing rules, schedule rotations and notification rules. " +
+			"When the window applies to every day of the week, a 'time-of-day' restriction is returned, otherwise a " +
+			"'weekday-and-time-of-day' restriction with one entry per day. Windows whose end is not after their start, " +
+			"such as 22:00 to 06:00, end on the following day.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "days",
+				Description: "The days the window applies to, as a comma separated list of days or ranges of days, " +
+					"e.g. 'mon-fri', 'sat,sun' or 'daily'. Days are full or three letter lowercase day names.",
+			},
+			function.StringParameter{
+				Name:        "start",
+				Description: "The time the window starts at, in 24-hour HH:MM format. Minutes must be either 00 or 30.",
+			},
+			function.StringParameter{
+				Name:        "end",
+				Description: "The time the window ends at, in 24-hour HH:MM format. Minutes must be either 00 or 30.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: dataModels.TimeRestrictionModelMap,
+		},
+	}
+}
+
+func (f *BusinessHoursFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var days, start, end string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &days, &start, &end))
+	if resp.Error != nil {
+		return
+	}
+
+	weekdays, err := parseBusinessDays(days)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	startHour, startMin, err := parseBusinessTime(start)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+	endHour, endMin, err := parseBusinessTime(end)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+	if startHour == endHour && startMin == endMin {
+		resp.Error = function.NewArgumentFuncError(2, "The end of the window must differ from its start.")
+		return
+	}
+
+	restriction := dataModels.TimeRestrictionModel{
+		Restriction: types.ObjectNull(dataModels.TimeOfDayTimeRestrictionSettingsModelMap),
+		Restrictions: types.ListNull(
+			types.ObjectType{AttrTypes: dataModels.WeekdayTimeRestrictionSettingsModelMap},
+		),
+	}
+
+	if len(weekdays) == len(businessHoursWeekdays) {
+		settings := dataModels.TimeOfDayTimeRestrictionSettingsModel{
+			StartHour: types.Int32Value(startHour),
+			EndHour:   types.Int32Value(endHour),
+			StartMin:  types.Int32Value(startMin),
+			EndMin:    types.Int32Value(endMin),
+		}
+		restriction.Type = types.StringValue("time-of-day")
+		restriction.Restriction = settings.AsValue()
+	} else {
+		// Windows ending at or before their start time run over midnight into the next day
+		overnight := endHour*60+endMin <= startHour*60+startMin
+
+		restrictions := make([]attr.Value, len(weekdays))
+		for i, day := range weekdays {
+			endDay := businessHoursWeekdays[day]
+			if overnight {
+				endDay = businessHoursWeekdays[(day+1)%len(businessHoursWeekdays)]
+			}
+			settings := dataModels.WeekdayTimeRestrictionSettingsModel{
+				StartDay:  types.StringValue(businessHoursWeekdays[day]),
+				EndDay:    types.StringValue(endDay),
+				StartHour: types.Int32Value(startHour),
+				EndHour:   types.Int32Value(endHour),
+				StartMin:  types.Int32Value(startMin),
+				EndMin:    types.Int32Value(endMin),
+			}
+			restrictions[i] = settings.AsValue()
+		}
+		restriction.Type = types.StringValue("weekday-and-time-of-day")
+		restriction.Restrictions = types.ListValueMust(
+			types.ObjectType{AttrTypes: dataModels.WeekdayTimeRestrictionSettingsModelMap},
+			restrictions,
+		)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, restriction.AsValue()))
+}
+
+// parseBusinessDays expands a list of days and ranges of days, such as mon-fri or sat,sun, to the indexes of the days
+// in businessHoursWeekdays. Ranges may wrap around the end of the week, e.g. fri-mon.
+func parseBusinessDays(days string) ([]int, error) {
+	if days == "daily" {
+		days = "mon-sun"
+	}
+
+	selected := make([]bool, len(businessHoursWeekdays))
+	for _, part := range strings.Split(days, ",") {
+		bounds := strings.Split(strings.TrimSpace(part), "-")
+		if len(bounds) > 2 {
+			return nil, fmt.Errorf("invalid range of days %q, expected e.g. 'mon-fri'", part)
+		}
+
+		first, err := parseBusinessDay(bounds[0])
+		if err != nil {
+			return nil, err
+		}
+		last := first
+		if len(bounds) == 2 {
+			if last, err = parseBusinessDay(bounds[1]); err != nil {
+				return nil, err
+			}
+		}
+
+		for day := first; ; day = (day + 1) % len(businessHoursWeekdays) {
+			selected[day] = true
+			if day == last {
+				break
+			}
+		}
+	}
+
+	var weekdays []int
+	for day, ok := range selected {
+		if ok {
+			weekdays = append(weekdays, day)
+		}
+	}
+	return weekdays, nil
+}
+
+func parseBusinessDay(day string) (int, error) {
+	day = strings.TrimSpace(day)
+	for i, weekday := range businessHoursWeekdays {
+		if day == weekday || day == weekday[:3] {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid day %q, expected a lowercase day name such as 'mon' or 'monday'", day)
+}
+
+// parseBusinessTime parses a time in HH:MM format into the hour and minute of a time restriction
+func parseBusinessTime(value string) (int32, int32, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid time %q, expected HH:MM format", value)
+	}
+
+	hour, err := strconv.ParseInt(parts[0], 10, 32)
+	if err != nil || hour < 0 || hour > 23 {
+		return 0, 0, fmt.Errorf("invalid hour in %q, expected a value between 00 and 23", value)
+	}
+	minute, err := strconv.ParseInt(parts[1], 10, 32)
+	if err != nil || (minute != 0 && minute != 30) {
+		return 0, 0, fmt.Errorf("invalid minute in %q, time restrictions only support 00 or 30", value)
+	}
+
+	return int32(hour), int32(minute), nil
+}
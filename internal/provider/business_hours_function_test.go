@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccBusinessHoursFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+output "weekdays" {
+  value = provider::atlassian-operations::business_hours("mon-tue", "09:00", "17:30")
+}
+
+output "overnight" {
+  value = provider::atlassian-operations::business_hours("sun", "22:00", "06:00")
+}
+
+output "daily" {
+  value = provider::atlassian-operations::business_hours("daily", "08:00", "20:00")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("weekdays", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"type":        knownvalue.StringExact("weekday-and-time-of-day"),
+						"restriction": knownvalue.Null(),
+						"restrictions": knownvalue.ListExact([]knownvalue.Check{
+							businessHoursCheck("monday", "monday", 9, 0, 17, 30),
+							businessHoursCheck("tuesday", "tuesday", 9, 0, 17, 30),
+						}),
+					})),
+					statecheck.ExpectKnownOutputValue("overnight", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"type":        knownvalue.StringExact("weekday-and-time-of-day"),
+						"restriction": knownvalue.Null(),
+						"restrictions": knownvalue.ListExact([]knownvalue.Check{
+							businessHoursCheck("sunday", "monday", 22, 0, 6, 0),
+						}),
+					})),
+					statecheck.ExpectKnownOutputValue("daily", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"type": knownvalue.StringExact("time-of-day"),
+						"restriction": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"start_hour": knownvalue.Int32Exact(8),
+							"start_min":  knownvalue.Int32Exact(0),
+							"end_hour":   knownvalue.Int32Exact(20),
+							"end_min":    knownvalue.Int32Exact(0),
+						}),
+						"restrictions": knownvalue.Null(),
+					})),
+				},
+			},
+			{
+				Config: providerConfig + `
+output "invalid" {
+  value = provider::atlassian-operations::business_hours("mon-fri", "09:15", "17:00")
+}
+`,
+				ExpectError: regexp.MustCompile(`invalid minute in "09:15"`),
+			},
+			{
+				Config: providerConfig + `
+output "invalid" {
+  value = provider::atlassian-operations::business_hours("weekdays", "09:00", "17:00")
+}
+`,
+				ExpectError: regexp.MustCompile(`invalid day "weekdays"`),
+			},
+		},
+	})
+}
+
+func businessHoursCheck(startDay string, endDay string, startHour int32, startMin int32, endHour int32, endMin int32) knownvalue.Check {
+	return knownvalue.ObjectExact(map[string]knownvalue.Check{
+		"start_day":  knownvalue.StringExact(startDay),
+		"end_day":    knownvalue.StringExact(endDay),
+		"start_hour": knownvalue.Int32Exact(startHour),
+		"start_min":  knownvalue.Int32Exact(startMin),
+		"end_hour":   knownvalue.Int32Exact(endHour),
+		"end_min":    knownvalue.Int32Exact(endMin),
+	})
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// validateTimeRestriction checks that a time_restriction object configures the
+// settings matching its type: a single restriction for 'time-of-day' and a list
+// of restrictions for 'weekday-and-time-of-day'.
+func validateTimeRestriction(ctx context.Context, value types.Object, p path.Path, diags *diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+
+	var timeRestriction dataModels.TimeRestrictionModel
+	diags.Append(value.As(ctx, &timeRestriction, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() || timeRestriction.Type.IsNull() || timeRestriction.Type.IsUnknown() {
+		return
+	}
+
+	switch timeRestriction.Type.ValueString() {
+	case "time-of-day":
+		if timeRestriction.Restriction.IsNull() {
+			diags.AddAttributeError(
+				p.AtName("restriction"),
+				"Invalid Time Restriction",
+				"restriction must be set when type is 'time-of-day'.",
+			)
+		}
+		if !timeRestriction.Restrictions.IsNull() {
+			diags.AddAttributeError(
+				p.AtName("restrictions"),
+				"Invalid Time Restriction",
+				"restrictions can only be set when type is 'weekday-and-time-of-day', use restriction instead.",
+			)
+		}
+	case "weekday-and-time-of-day":
+		if timeRestriction.Restrictions.IsNull() || (!timeRestriction.Restrictions.IsUnknown() && len(timeRestriction.Restrictions.Elements()) == 0) {
+			diags.AddAttributeError(
+				p.AtName("restrictions"),
+				"Invalid Time Restriction",
+				"At least one entry in restrictions must be set when type is 'weekday-and-time-of-day'.",
+			)
+		}
+		if !timeRestriction.Restriction.IsNull() {
+			diags.AddAttributeError(
+				p.AtName("restriction"),
+				"Invalid Time Restriction",
+				"restriction can only be set when type is 'time-of-day', use restrictions instead.",
+			)
+		}
+	}
+}
+
+// validatePolicyTimeRestrictionPeriod checks that a time restriction period of
+// an alert or notification policy does not start and end at the same time.
+func validatePolicyTimeRestrictionPeriod(startHour, startMinute, endHour, endMinute types.Int64, p path.Path, diags *diag.Diagnostics) {
+	for _, value := range []types.Int64{startHour, startMinute, endHour, endMinute} {
+		if value.IsNull() || value.IsUnknown() {
+			return
+		}
+	}
+
+	if startHour.Equal(endHour) && startMinute.Equal(endMinute) {
+		diags.AddAttributeError(
+			p.AtName("end_hour"),
+			"Invalid Time Restriction Period",
+			"The end of a time restriction period must differ from its start.",
+		)
+	}
+}
+
+// validateDurationFormat checks that an action configuring wait_duration also
+// configures the duration_format the duration is expressed in.
+func validateDurationFormat(waitDuration types.Int64, durationFormat types.String, p path.Path, diags *diag.Diagnostics) {
+	if waitDuration.IsNull() || !durationFormat.IsNull() {
+		return
+	}
+
+	diags.AddAttributeError(
+		p.AtName("duration_format"),
+		"Missing Duration Format",
+		"duration_format must be set when wait_duration is set, e.g. 'minutes' or 'hours'.",
+	)
+}
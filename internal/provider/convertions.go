@@ -9,8 +9,8 @@ import (
 	"strings"
 
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/customTypes"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -467,6 +467,56 @@ func WeekdayTimeRestrictionSettingsModelToDto(model dataModels.WeekdayTimeRestri
 	}
 }
 
+func TimeRestrictionDtoToModel(dtoObj *dto.TimeRestriction) types.Object {
+	if dtoObj == nil {
+		return types.ObjectNull(dataModels.TimeRestrictionModelMap)
+	}
+
+	attributes := map[string]attr.Value{
+		"type":        types.StringValue(string(dtoObj.Type)),
+		"restriction": types.ObjectNull(dataModels.TimeOfDayTimeRestrictionSettingsModelMap),
+		"restrictions": types.ListNull(
+			types.ObjectType{AttrTypes: dataModels.WeekdayTimeRestrictionSettingsModelMap},
+		),
+	}
+
+	if dtoObj.TimeOfDayRestriction != nil {
+		attributes["restriction"] = types.ObjectValueMust(
+			dataModels.TimeOfDayTimeRestrictionSettingsModelMap,
+			map[string]attr.Value{
+				"start_hour": types.Int32Value(dtoObj.TimeOfDayRestriction.StartHour),
+				"end_hour":   types.Int32Value(dtoObj.TimeOfDayRestriction.EndHour),
+				"start_min":  types.Int32Value(dtoObj.TimeOfDayRestriction.StartMin),
+				"end_min":    types.Int32Value(dtoObj.TimeOfDayRestriction.EndMin),
+			},
+		)
+	}
+
+	if dtoObj.WeekAndTimeOfDayRestriction != nil {
+		restrictions := make([]attr.Value, len(*dtoObj.WeekAndTimeOfDayRestriction))
+		for i, restriction := range *dtoObj.WeekAndTimeOfDayRestriction {
+			restrictions[i] = types.ObjectValueMust(
+				dataModels.WeekdayTimeRestrictionSettingsModelMap,
+				map[string]attr.Value{
+					"start_day":  types.StringValue(string(restriction.StartDay)),
+					"end_day":    types.StringValue(string(restriction.EndDay)),
+					"start_hour": types.Int32Value(restriction.StartHour),
+					"end_hour":   types.Int32Value(restriction.EndHour),
+					"start_min":  types.Int32Value(restriction.StartMin),
+					"end_min":    types.Int32Value(restriction.EndMin),
+				},
+			)
+		}
+
+		attributes["restrictions"] = types.ListValueMust(
+			types.ObjectType{AttrTypes: dataModels.WeekdayTimeRestrictionSettingsModelMap},
+			restrictions,
+		)
+	}
+
+	return types.ObjectValueMust(dataModels.TimeRestrictionModelMap, attributes)
+}
+
 func TeamModelToDto(ctx context.Context, model dataModels.TeamModel) (dto.TeamDto, []dto.TeamMember) {
 	userPermissions := dataModels.PublicApiUserPermissionsModel{}
 	model.UserPermissions.As(ctx, &userPermissions, basetypes.ObjectAsOptions{})
@@ -728,8 +778,10 @@ func ApiIntegrationDtoToModel(dtoObj dto.ApiIntegration, oldModel dataModels.Api
 		MaintenanceSources:     types.ListNull(types.ObjectType{AttrTypes: dataModels.IntegrationMaintenanceSourcesResponseModelMap}),
 		Directions:             types.ListNull(types.StringType),
 		Domains:                types.ListNull(types.StringType),
-		TypeSpecificProperties: jsontypes.NewExactValue(string(typeSpecificProperties)),
+		TypeSpecificProperties: customTypes.NewJsonWithDefaultsValue(string(typeSpecificProperties)),
+		RotateKeyTrigger:       oldModel.RotateKeyTrigger,
 		DeleteDefaultActions:   oldModel.DeleteDefaultActions,
+		DeletionProtection:     oldModel.DeletionProtection,
 	}
 
 	if dtoObj.ApiKey != "" {
@@ -950,6 +1002,50 @@ func RoutingRuleDtoToModel(teamId string, dto dto.RoutingRuleDto) dataModels.Rou
 	return model
 }
 
+func RoutingRulesRuleModelToDto(ctx context.Context, model dataModels.RoutingRulesRuleModel) dto.RoutingRuleDto {
+	return RoutingRuleModelToDto(ctx, dataModels.RoutingRuleModel{
+		ID:              model.ID,
+		Name:            model.Name,
+		Order:           types.Int64Null(),
+		IsDefault:       types.BoolValue(false),
+		Timezone:        model.Timezone,
+		Criteria:        model.Criteria,
+		TimeRestriction: model.TimeRestriction,
+		Notify:          model.Notify,
+	})
+}
+
+// RoutingRulesDtoToModel expects the routing rules of the team sorted by their order.
+func RoutingRulesDtoToModel(teamId string, rules []dto.RoutingRuleDto) dataModels.RoutingRulesModel {
+	model := dataModels.RoutingRulesModel{
+		ID:            types.StringValue(teamId),
+		TeamID:        types.StringValue(teamId),
+		DefaultNotify: types.ObjectNull(dataModels.RoutingRuleNotifyModelMap),
+	}
+
+	ruleValues := make([]attr.Value, 0, len(rules))
+	for _, rule := range rules {
+		ruleModel := RoutingRuleDtoToModel(teamId, rule)
+		if rule.IsDefault {
+			model.DefaultNotify = ruleModel.Notify
+			continue
+		}
+
+		element := dataModels.RoutingRulesRuleModel{
+			ID:              ruleModel.ID,
+			Name:            ruleModel.Name,
+			Timezone:        ruleModel.Timezone,
+			Criteria:        ruleModel.Criteria,
+			TimeRestriction: ruleModel.TimeRestriction,
+			Notify:          ruleModel.Notify,
+		}
+		ruleValues = append(ruleValues, element.AsValue())
+	}
+	model.Rules = types.ListValueMust(types.ObjectType{AttrTypes: dataModels.RoutingRulesRuleModelMap}, ruleValues)
+
+	return model
+}
+
 func CriteriaDtoToModel(dto *dto.CriteriaDto) dataModels.CriteriaModel {
 	model := dataModels.CriteriaModel{
 		Type: types.StringValue(string(dto.Type)),
@@ -1092,7 +1188,7 @@ func NotificationRuleModelToDto(ctx context.Context, model dataModels.Notificati
 	}, nil
 }
 
-func NotificationRuleDtoToModel(_ context.Context, dto dto.NotificationRuleDto) dataModels.NotificationRuleModel {
+func NotificationRuleDtoToModel(_ context.Context, userId types.String, dto dto.NotificationRuleDto) dataModels.NotificationRuleModel {
 	var notificationTime types.Set
 	if dto.NotificationTime != nil {
 		elements := make([]attr.Value, len(dto.NotificationTime))
@@ -1174,7 +1270,7 @@ func NotificationRuleDtoToModel(_ context.Context, dto dto.NotificationRuleDto)
 				dataModels.NotificationContactModelMap,
 				map[string]attr.Value{
 					"method": types.StringValue(step.Contact.Method),
-					"to":     types.StringValue(step.Contact.To),
+					"to":     customTypes.NewContactAddressValue(step.Contact.To),
 				},
 			)
 
@@ -1215,6 +1311,7 @@ func NotificationRuleDtoToModel(_ context.Context, dto dto.NotificationRuleDto)
 
 	return dataModels.NotificationRuleModel{
 		ID:               types.StringValue(dto.ID),
+		UserId:           userId,
 		Name:             types.StringValue(dto.Name),
 		ActionType:       types.StringValue(dto.ActionType),
 		NotificationTime: notificationTime,
@@ -1239,8 +1336,9 @@ func UserContactModelToDto(model *dataModels.UserContactModel) dto.UserContactDt
 func UserContactDtoToModel(dto *dto.UserContactDto) *dataModels.UserContactModel {
 	return &dataModels.UserContactModel{
 		ID:      types.StringValue(dto.ID),
+		UserId:  types.StringNull(),
 		Method:  types.StringValue(dto.Method),
-		To:      types.StringValue(dto.To),
+		To:      customTypes.NewContactAddressValue(dto.To),
 		Enabled: types.BoolValue(dto.Enabled),
 	}
 }
@@ -1248,17 +1346,19 @@ func UserContactDtoToModel(dto *dto.UserContactDto) *dataModels.UserContactModel
 func UserContactCUDDtoToModel(dto *dto.UserContactCUDResponseDto, data *dataModels.UserContactModel) *dataModels.UserContactModel {
 	return &dataModels.UserContactModel{
 		ID:      types.StringValue(dto.Data.ID),
+		UserId:  data.UserId,
 		Method:  data.Method,
 		To:      data.To,
 		Enabled: data.Enabled,
 	}
 }
 
-func UserContactReadDtoToModel(dto *dto.UserContactDataReadResponseDto) *dataModels.UserContactModel {
+func UserContactReadDtoToModel(userId types.String, dto *dto.UserContactDataReadResponseDto) *dataModels.UserContactModel {
 	return &dataModels.UserContactModel{
 		ID:      types.StringValue(dto.ID),
+		UserId:  userId,
 		Method:  types.StringValue(dto.Method),
-		To:      types.StringValue(dto.To),
+		To:      customTypes.NewContactAddressValue(dto.To),
 		Enabled: types.BoolValue(dto.Status.Enabled),
 	}
 }
@@ -1721,12 +1821,22 @@ func CustomRoleDtoToModel(dto *dto.CustomRoleDto) *dataModels.CustomRoleModel {
 }
 
 func CustomRoleCUDDtoToModel(dto *dto.CustomRoleCUDResponseDto, data *dataModels.CustomRoleModel) *dataModels.CustomRoleModel {
+	// Rights left out of the configuration are sent as empty sets
+	grantedRights := data.GrantedRights
+	if grantedRights.IsUnknown() {
+		grantedRights = types.SetValueMust(types.StringType, []attr.Value{})
+	}
+	disallowedRights := data.DisallowedRights
+	if disallowedRights.IsUnknown() {
+		disallowedRights = types.SetValueMust(types.StringType, []attr.Value{})
+	}
+
 	return &dataModels.CustomRoleModel{
 		ID: types.StringValue(dto.Data.ID),
 		// JSM OPS API does not return the updated name of the custom role
 		Name:             data.Name,
-		GrantedRights:    data.GrantedRights,
-		DisallowedRights: data.DisallowedRights,
+		GrantedRights:    grantedRights,
+		DisallowedRights: disallowedRights,
 	}
 }
 
@@ -2367,7 +2477,7 @@ func IntegrationActionDtoToModel(ctx context.Context, dto *dto.IntegrationAction
 			dataModels.ActionMappingModelMap,
 			map[string]attr.Value{
 				"type":      types.StringValue(dto.ActionMapping.Type),
-				"parameter": jsontypes.NewExactValue(string(parameterMap)),
+				"parameter": customTypes.NewJsonWithDefaultsValue(string(parameterMap)),
 			},
 		)
 	} else {
@@ -2409,8 +2519,8 @@ func IntegrationActionDtoToModel(ctx context.Context, dto *dto.IntegrationAction
 		Direction:              types.StringValue(dto.Direction),
 		GroupType:              groupType,
 		Filter:                 filter,
-		TypeSpecificProperties: jsontypes.NewExactValue(string(typeSpecificPropsMap)),
-		FieldMappings:          jsontypes.NewExactValue(string(fieldMappingsMap)),
+		TypeSpecificProperties: customTypes.NewJsonWithDefaultsValue(string(typeSpecificPropsMap)),
+		FieldMappings:          customTypes.NewJsonWithDefaultsValue(string(fieldMappingsMap)),
 		ActionMapping:          actionMapping,
 		Enabled:                enabled,
 	}, diags
@@ -2641,6 +2751,25 @@ func ServiceModelToDto(ctx context.Context, model *dataModels.ServiceModel, clou
 		}
 	}
 
+	// Convert links, only supported by Compass components
+	var links []dto.ServiceLinkDto
+	if !(model.Links.IsNull() || model.Links.IsUnknown()) {
+		var linkModels []dataModels.ServiceLinkModel
+		diags.Append(model.Links.ElementsAs(ctx, &linkModels, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		links = make([]dto.ServiceLinkDto, len(linkModels))
+		for i, link := range linkModels {
+			links[i] = dto.ServiceLinkDto{
+				Type: link.Type.ValueString(),
+				Url:  link.Url.ValueString(),
+				Name: link.Name.ValueString(),
+			}
+		}
+	}
+
 	return &dto.ServiceDto{
 		ID:              model.ID.ValueString(),
 		Name:            model.Name.ValueString(),
@@ -2652,6 +2781,7 @@ func ServiceModelToDto(ctx context.Context, model *dataModels.ServiceModel, clou
 		Responders:      responders,
 		Stakeholders:    stakeholders,
 		Projects:        projects,
+		Links:           links,
 	}, diags
 }
 
@@ -2745,6 +2875,29 @@ func ServiceDtoToModel(ctx context.Context, dto *dto.ServiceDto) (*dataModels.Se
 		projects = types.ObjectNull(dataModels.ProjectsModelMap)
 	}
 
+	// Convert links
+	var links types.List
+	if len(dto.Links) > 0 {
+		linkValues := make([]attr.Value, 0, len(dto.Links))
+		for _, link := range dto.Links {
+			name := types.StringNull()
+			if link.Name != "" {
+				name = types.StringValue(link.Name)
+			}
+			linkValues = append(linkValues, types.ObjectValueMust(
+				dataModels.ServiceLinkModelMap,
+				map[string]attr.Value{
+					"type": types.StringValue(link.Type),
+					"url":  types.StringValue(link.Url),
+					"name": name,
+				},
+			))
+		}
+		links = types.ListValueMust(types.ObjectType{AttrTypes: dataModels.ServiceLinkModelMap}, linkValues)
+	} else {
+		links = types.ListNull(types.ObjectType{AttrTypes: dataModels.ServiceLinkModelMap})
+	}
+
 	return &dataModels.ServiceModel{
 		ID:              types.StringValue(dto.ID),
 		Name:            types.StringValue(dto.Name),
@@ -2756,5 +2909,195 @@ func ServiceDtoToModel(ctx context.Context, dto *dto.ServiceDto) (*dataModels.Se
 		Responders:      responders,
 		Stakeholders:    stakeholders,
 		Projects:        projects,
+		Links:           links,
 	}, diags
 }
+
+func ServiceRelationshipModelToDto(model dataModels.ServiceRelationshipModel) dto.ServiceRelationshipDto {
+	return dto.ServiceRelationshipDto{
+		ID:              model.ID.ValueString(),
+		SourceServiceId: model.SourceServiceId.ValueString(),
+		TargetServiceId: model.TargetServiceId.ValueString(),
+		Type:            model.Type.ValueString(),
+	}
+}
+
+func ServiceRelationshipDtoToModel(sourceServiceId string, dtoObj dto.ServiceRelationshipDto) dataModels.ServiceRelationshipModel {
+	if dtoObj.SourceServiceId != "" {
+		sourceServiceId = dtoObj.SourceServiceId
+	}
+	return dataModels.ServiceRelationshipModel{
+		ID:              types.StringValue(dtoObj.ID),
+		SourceServiceId: types.StringValue(sourceServiceId),
+		TargetServiceId: types.StringValue(dtoObj.TargetServiceId),
+		Type:            types.StringValue(dtoObj.Type),
+	}
+}
+
+func IncomingCallRoutingModelToDto(ctx context.Context, model *dataModels.IncomingCallRoutingModel) (*dto.IncomingCallRoutingDto, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	dtoObj := dto.IncomingCallRoutingDto{
+		ID:              model.ID.ValueString(),
+		Name:            model.Name.ValueString(),
+		CountryCode:     model.CountryCode.ValueString(),
+		GreetingMessage: model.GreetingMessage.ValueString(),
+		Enabled:         model.Enabled.ValueBool(),
+	}
+
+	if !(model.Voice.IsNull() || model.Voice.IsUnknown()) {
+		var voice dataModels.IncomingCallRoutingVoiceModel
+		diags.Append(model.Voice.As(ctx, &voice, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		dtoObj.Voice = &dto.IncomingCallRoutingVoice{
+			Language: voice.Language.ValueString(),
+			Gender:   voice.Gender.ValueString(),
+		}
+	}
+
+	var recipients []dataModels.ResponderInfoModel
+	diags.Append(model.Recipients.ElementsAs(ctx, &recipients, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	dtoObj.Recipients = make([]dto.ResponderInfo, len(recipients))
+	for i, recipient := range recipients {
+		dtoObj.Recipients[i] = ResponderInfoModelToDto(recipient)
+	}
+
+	return &dtoObj, diags
+}
+
+func IncomingCallRoutingDtoToModel(teamId string, dtoObj *dto.IncomingCallRoutingDto) dataModels.IncomingCallRoutingModel {
+	model := dataModels.IncomingCallRoutingModel{
+		ID:              types.StringValue(dtoObj.ID),
+		TeamID:          types.StringValue(teamId),
+		Name:            types.StringValue(dtoObj.Name),
+		CountryCode:     types.StringValue(dtoObj.CountryCode),
+		PhoneNumber:     types.StringValue(dtoObj.PhoneNumber),
+		GreetingMessage: types.StringNull(),
+		Voice:           types.ObjectNull(dataModels.IncomingCallRoutingVoiceModelMap),
+		Enabled:         types.BoolValue(dtoObj.Enabled),
+	}
+
+	if dtoObj.GreetingMessage != "" {
+		model.GreetingMessage = types.StringValue(dtoObj.GreetingMessage)
+	}
+
+	if dtoObj.Voice != nil {
+		voice := dataModels.IncomingCallRoutingVoiceModel{
+			Language: types.StringValue(dtoObj.Voice.Language),
+			Gender:   types.StringValue(dtoObj.Voice.Gender),
+		}
+		model.Voice = voice.AsValue()
+	}
+
+	recipients := make([]attr.Value, len(dtoObj.Recipients))
+	for i, recipient := range dtoObj.Recipients {
+		recipientModel := ResponderInfoDtoToModel(recipient)
+		recipients[i] = recipientModel.AsValue()
+	}
+	model.Recipients = types.ListValueMust(types.ObjectType{AttrTypes: dataModels.ResponderInfoModelMap}, recipients)
+
+	return model
+}
+
+func TeamRoleModelToDto(ctx context.Context, model *dataModels.TeamRoleModel) (*dto.TeamRoleDto, diag.Diagnostics) {
+	var grantedRights []string
+	diags := model.GrantedRights.ElementsAs(ctx, &grantedRights, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// Rights left out of the configuration are sent as an empty list, which clears them on update
+	disallowedRights := make([]string, 0)
+	if !model.DisallowedRights.IsNull() {
+		diags.Append(model.DisallowedRights.ElementsAs(ctx, &disallowedRights, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	return &dto.TeamRoleDto{
+		ID:               model.ID.ValueString(),
+		Name:             model.Name.ValueString(),
+		GrantedRights:    grantedRights,
+		DisallowedRights: disallowedRights,
+	}, diags
+}
+
+func TeamRoleDtoToModel(teamId string, dtoObj *dto.TeamRoleDto) dataModels.TeamRoleModel {
+	grantedRights := make([]attr.Value, len(dtoObj.GrantedRights))
+	for i, right := range dtoObj.GrantedRights {
+		grantedRights[i] = types.StringValue(right)
+	}
+
+	disallowedRights := types.SetNull(types.StringType)
+	if len(dtoObj.DisallowedRights) > 0 {
+		elements := make([]attr.Value, len(dtoObj.DisallowedRights))
+		for i, right := range dtoObj.DisallowedRights {
+			elements[i] = types.StringValue(right)
+		}
+		disallowedRights = types.SetValueMust(types.StringType, elements)
+	}
+
+	return dataModels.TeamRoleModel{
+		ID:               types.StringValue(dtoObj.ID),
+		TeamID:           types.StringValue(teamId),
+		Name:             types.StringValue(dtoObj.Name),
+		GrantedRights:    types.SetValueMust(types.StringType, grantedRights),
+		DisallowedRights: disallowedRights,
+	}
+}
+
+func TeamMemberRoleDtoToModel(teamId string, accountId string, roleDto *dto.TeamMemberRoleDto) dataModels.TeamMemberRoleModel {
+	return dataModels.TeamMemberRoleModel{
+		ID:        types.StringValue(teamId + "," + accountId),
+		TeamID:    types.StringValue(teamId),
+		AccountId: types.StringValue(accountId),
+		Role:      types.StringValue(roleDto.Role),
+	}
+}
+
+func UserQuietHoursModelToDto(ctx context.Context, model *dataModels.UserQuietHoursModel) (*dto.UserQuietHoursDto, diag.Diagnostics) {
+	var timeRestriction dataModels.TimeRestrictionModel
+	diags := model.TimeRestriction.As(ctx, &timeRestriction, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &dto.UserQuietHoursDto{
+		Enabled:         model.Enabled.ValueBool(),
+		TimeRestriction: TimeRestrictionModelToDto(ctx, timeRestriction),
+	}, diags
+}
+
+func UserQuietHoursDtoToModel(dtoObj *dto.UserQuietHoursDto) dataModels.UserQuietHoursModel {
+	return dataModels.UserQuietHoursModel{
+		ID:              types.StringValue(dtoObj.UserId),
+		Enabled:         types.BoolValue(dtoObj.Enabled),
+		TimeRestriction: TimeRestrictionDtoToModel(dtoObj.TimeRestriction),
+	}
+}
+
+func UserForwardingRuleModelToDto(model *dataModels.UserForwardingRuleModel) *dto.ForwardingRuleDto {
+	return &dto.ForwardingRuleDto{
+		ID:        model.ID.ValueString(),
+		FromUser:  model.FromUserId.ValueString(),
+		ToUser:    model.ToUserId.ValueString(),
+		StartDate: model.StartDate.ValueString(),
+		EndDate:   model.EndDate.ValueString(),
+	}
+}
+
+func UserForwardingRuleDtoToModel(dtoObj *dto.ForwardingRuleDto) dataModels.UserForwardingRuleModel {
+	return dataModels.UserForwardingRuleModel{
+		ID:         types.StringValue(dtoObj.ID),
+		FromUserId: types.StringValue(dtoObj.FromUser),
+		ToUserId:   types.StringValue(dtoObj.ToUser),
+		StartDate:  timetypes.NewRFC3339ValueMust(dtoObj.StartDate),
+		EndDate:    timetypes.NewRFC3339ValueMust(dtoObj.EndDate),
+	}
+}
@@ -0,0 +1,60 @@
+package customTypes
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*ContactAddressType)(nil)
+
+// ContactAddressType is the type of contact addresses, phone numbers, email
+// addresses or mobile device ids, whose values compare by their normalized form.
+type ContactAddressType struct {
+	basetypes.StringType
+}
+
+func (t ContactAddressType) String() string {
+	return "customTypes.ContactAddressType"
+}
+
+func (t ContactAddressType) ValueType(_ context.Context) attr.Value {
+	return ContactAddress{}
+}
+
+func (t ContactAddressType) Equal(o attr.Type) bool {
+	other, ok := o.(ContactAddressType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t ContactAddressType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return ContactAddress{
+		StringValue: in,
+	}, nil
+}
+
+func (t ContactAddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,103 @@
+package customTypes
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"regexp"
+	"strings"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*ContactAddress)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*ContactAddress)(nil)
+)
+
+// phoneNumberFormatting matches the separators people write phone numbers with
+var phoneNumberFormatting = regexp.MustCompile(`[\s().-]`)
+
+var phoneNumberDigits = regexp.MustCompile(`^\+?[0-9]+$`)
+
+// ContactAddress is a contact address which is considered equal to another
+// one when both normalize to the same value. Phone numbers normalize to their
+// E.164 form and email addresses to a lower case domain, so the formatting the
+// API applies to stored contacts does not show up as a change.
+type ContactAddress struct {
+	basetypes.StringValue
+}
+
+func (v ContactAddress) Type(_ context.Context) attr.Type {
+	return ContactAddressType{}
+}
+
+func (v ContactAddress) Equal(o attr.Value) bool {
+	other, ok := o.(ContactAddress)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals is called by the framework on the new value, e.g. the
+// one read from the API, with the prior value as argument.
+func (v ContactAddress) StringSemanticEquals(_ context.Context, priorValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	priorValue, ok := priorValuable.(ContactAddress)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", priorValuable),
+		)
+
+		return false, diags
+	}
+
+	return NormalizeContactAddress(v.ValueString()) == NormalizeContactAddress(priorValue.ValueString()), diags
+}
+
+// NormalizePhoneNumber returns the E.164 form of a phone number, without
+// formatting and with a leading plus sign, and whether value is a phone number.
+// The country code separator the API uses, as in 49-5360287176, is accepted.
+func NormalizePhoneNumber(value string) (string, bool) {
+	digits := phoneNumberFormatting.ReplaceAllString(strings.TrimSpace(value), "")
+	if !phoneNumberDigits.MatchString(digits) {
+		return "", false
+	}
+
+	return "+" + strings.TrimPrefix(digits, "+"), true
+}
+
+// NormalizeContactAddress returns the normalized form of a phone number, an
+// email address or a mobile device id.
+func NormalizeContactAddress(value string) string {
+	if phoneNumber, ok := NormalizePhoneNumber(value); ok {
+		return phoneNumber
+	}
+
+	value = strings.TrimSpace(value)
+	if at := strings.LastIndex(value, "@"); at != -1 {
+		// Domains are case insensitive, local parts are kept as they are
+		return value[:at] + strings.ToLower(value[at:])
+	}
+
+	return value
+}
+
+func NewContactAddressNull() ContactAddress {
+	return ContactAddress{StringValue: basetypes.NewStringNull()}
+}
+
+func NewContactAddressUnknown() ContactAddress {
+	return ContactAddress{StringValue: basetypes.NewStringUnknown()}
+}
+
+func NewContactAddressValue(value string) ContactAddress {
+	return ContactAddress{StringValue: basetypes.NewStringValue(value)}
+}
@@ -0,0 +1,174 @@
+package customTypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestNormalizePhoneNumber(t *testing.T) {
+	tests := map[string]struct {
+		value         string
+		expected      string
+		expectedPhone bool
+	}{
+		"e164": {
+			value:         "+15550100000",
+			expected:      "+15550100000",
+			expectedPhone: true,
+		},
+		"formatted": {
+			value:         "+1 (555) 010-0000",
+			expected:      "+15550100000",
+			expectedPhone: true,
+		},
+		"api country code separator": {
+			value:         "1-5550100000",
+			expected:      "+15550100000",
+			expectedPhone: true,
+		},
+		"without plus sign": {
+			value:         "15550100000",
+			expected:      "+15550100000",
+			expectedPhone: true,
+		},
+		"dots and surrounding spaces": {
+			value:         " 49.536.028.7176 ",
+			expected:      "+495360287176",
+			expectedPhone: true,
+		},
+		"email address": {
+			value:         "oncall@example.com",
+			expectedPhone: false,
+		},
+		"plus sign in the middle": {
+			value:         "1+5550100000",
+			expectedPhone: false,
+		},
+		"letters": {
+			value:         "+1 555 CALL NOW",
+			expectedPhone: false,
+		},
+		"empty": {
+			value:         "",
+			expectedPhone: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			phoneNumber, ok := NormalizePhoneNumber(test.value)
+			if ok != test.expectedPhone {
+				t.Fatalf("expected phone number: %t, got %t", test.expectedPhone, ok)
+			}
+			if phoneNumber != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, phoneNumber)
+			}
+		})
+	}
+}
+
+func TestNormalizeContactAddress(t *testing.T) {
+	tests := map[string]struct {
+		value    string
+		expected string
+	}{
+		"formatted phone number": {
+			value:    "+1 (555) 010-0000",
+			expected: "+15550100000",
+		},
+		"api phone number": {
+			value:    "1-5550100000",
+			expected: "+15550100000",
+		},
+		"email address": {
+			value:    "oncall@example.com",
+			expected: "oncall@example.com",
+		},
+		"email domain in mixed case": {
+			value:    "OnCall@Example.COM",
+			expected: "OnCall@example.com",
+		},
+		"email address with surrounding spaces": {
+			value:    " oncall@example.com ",
+			expected: "oncall@example.com",
+		},
+		"mobile device id": {
+			value:    "7f3c2a9e-41b8-4d55-9a4e-0c6b1f2d3e4a",
+			expected: "7f3c2a9e-41b8-4d55-9a4e-0c6b1f2d3e4a",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if normalized := NormalizeContactAddress(test.value); normalized != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, normalized)
+			}
+		})
+	}
+}
+
+func TestContactAddressStringSemanticEquals(t *testing.T) {
+	tests := map[string]struct {
+		// newValue is the value read from the API, prior the value of the plan or state
+		newValue      string
+		prior         basetypes.StringValuable
+		expected      bool
+		expectedError bool
+	}{
+		"api phone number format": {
+			newValue: "1-5550100000",
+			prior:    NewContactAddressValue("+1 (555) 010-0000"),
+			expected: true,
+		},
+		"phone number without plus sign": {
+			newValue: "+15550100000",
+			prior:    NewContactAddressValue("15550100000"),
+			expected: true,
+		},
+		"different phone numbers": {
+			newValue: "1-5550100001",
+			prior:    NewContactAddressValue("+15550100000"),
+			expected: false,
+		},
+		"email domain in mixed case": {
+			newValue: "oncall@example.com",
+			prior:    NewContactAddressValue("oncall@Example.com"),
+			expected: true,
+		},
+		"email local part in mixed case": {
+			newValue: "oncall@example.com",
+			prior:    NewContactAddressValue("OnCall@example.com"),
+			expected: false,
+		},
+		"digit only mobile device id": {
+			newValue: "1234567890",
+			prior:    NewContactAddressValue("1234567890"),
+			expected: true,
+		},
+		"different digit only mobile device ids": {
+			newValue: "1234567890",
+			prior:    NewContactAddressValue("1234567891"),
+			expected: false,
+		},
+		"unexpected type": {
+			newValue:      "+15550100000",
+			prior:         basetypes.NewStringValue("+15550100000"),
+			expectedError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// The framework calls the method on the new value with the prior value as argument
+			equal, diags := NewContactAddressValue(test.newValue).StringSemanticEquals(context.Background(), test.prior)
+			if diags.HasError() != test.expectedError {
+				t.Fatalf("expected error: %t, got diagnostics: %v", test.expectedError, diags)
+			}
+			if equal != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, equal)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package customTypes
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*JsonWithDefaultsType)(nil)
+
+// JsonWithDefaultsType is a JSON string type whose values ignore key ordering,
+// formatting and keys the API adds on top of the configured document.
+type JsonWithDefaultsType struct {
+	jsontypes.NormalizedType
+}
+
+func (t JsonWithDefaultsType) String() string {
+	return "customTypes.JsonWithDefaultsType"
+}
+
+func (t JsonWithDefaultsType) ValueType(_ context.Context) attr.Value {
+	return JsonWithDefaults{}
+}
+
+func (t JsonWithDefaultsType) Equal(o attr.Type) bool {
+	other, ok := o.(JsonWithDefaultsType)
+	if !ok {
+		return false
+	}
+
+	return t.NormalizedType.Equal(other.NormalizedType)
+}
+
+func (t JsonWithDefaultsType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return JsonWithDefaults{
+		Normalized: jsontypes.Normalized{StringValue: in},
+	}, nil
+}
+
+func (t JsonWithDefaultsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
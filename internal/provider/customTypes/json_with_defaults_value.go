@@ -0,0 +1,140 @@
+package customTypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"strings"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*JsonWithDefaults)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*JsonWithDefaults)(nil)
+)
+
+// JsonWithDefaults is a normalized JSON string which is considered equal to a
+// newer value as long as every key of the prior document is present in the
+// newer one with an equal value. Keys that only exist in the newer document
+// are defaults injected by the API and are ignored.
+type JsonWithDefaults struct {
+	jsontypes.Normalized
+}
+
+func (v JsonWithDefaults) Type(_ context.Context) attr.Type {
+	return JsonWithDefaultsType{}
+}
+
+func (v JsonWithDefaults) Equal(o attr.Value) bool {
+	other, ok := o.(JsonWithDefaults)
+	if !ok {
+		return false
+	}
+
+	return v.Normalized.Equal(other.Normalized)
+}
+
+// StringSemanticEquals is called by the framework on the new value, e.g. the
+// one read from the API, with the prior value as argument.
+func (v JsonWithDefaults) StringSemanticEquals(_ context.Context, priorValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	priorValue, ok := priorValuable.(JsonWithDefaults)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", priorValuable),
+		)
+
+		return false, diags
+	}
+
+	newDocument, err := decodeJson(v.ValueString())
+	if err != nil {
+		diags.AddError("Semantic Equality Check Error", "Unable to parse the new JSON value: "+err.Error())
+		return false, diags
+	}
+
+	priorDocument, err := decodeJson(priorValue.ValueString())
+	if err != nil {
+		diags.AddError("Semantic Equality Check Error", "Unable to parse the prior JSON value: "+err.Error())
+		return false, diags
+	}
+
+	return jsonContains(newDocument, priorDocument), diags
+}
+
+func decodeJson(value string) (interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+// jsonContains reports whether actual holds everything declared in expected.
+// Objects may carry additional keys, every other value must match exactly.
+func jsonContains(actual, expected interface{}) bool {
+	switch expectedValue := expected.(type) {
+	case map[string]interface{}:
+		if actual == nil && len(expectedValue) == 0 {
+			return true
+		}
+		actualValue, ok := actual.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, value := range expectedValue {
+			other, exists := actualValue[key]
+			if !exists || !jsonContains(other, value) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		actualValue, ok := actual.([]interface{})
+		if !ok || len(actualValue) != len(expectedValue) {
+			return false
+		}
+		for i := range expectedValue {
+			if !jsonContains(actualValue[i], expectedValue[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number:
+		actualValue, ok := actual.(json.Number)
+		if !ok {
+			return false
+		}
+		if expectedValue == actualValue {
+			return true
+		}
+		expectedFloat, expectedErr := expectedValue.Float64()
+		actualFloat, actualErr := actualValue.Float64()
+		return expectedErr == nil && actualErr == nil && expectedFloat == actualFloat
+	default:
+		return actual == expected
+	}
+}
+
+func NewJsonWithDefaultsNull() JsonWithDefaults {
+	return JsonWithDefaults{Normalized: jsontypes.NewNormalizedNull()}
+}
+
+func NewJsonWithDefaultsUnknown() JsonWithDefaults {
+	return JsonWithDefaults{Normalized: jsontypes.NewNormalizedUnknown()}
+}
+
+func NewJsonWithDefaultsValue(value string) JsonWithDefaults {
+	return JsonWithDefaults{Normalized: jsontypes.NewNormalizedValue(value)}
+}
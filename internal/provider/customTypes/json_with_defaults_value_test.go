@@ -0,0 +1,117 @@
+package customTypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestJsonWithDefaultsStringSemanticEquals(t *testing.T) {
+	tests := map[string]struct {
+		// newValue is the value read from the API, prior the value of the plan or state
+		newValue      string
+		prior         basetypes.StringValuable
+		expected      bool
+		expectedError bool
+	}{
+		"equal": {
+			newValue: `{"a":1,"b":"value"}`,
+			prior:    NewJsonWithDefaultsValue(`{"a":1,"b":"value"}`),
+			expected: true,
+		},
+		"reordered keys": {
+			newValue: `{"b":"value","a":1}`,
+			prior:    NewJsonWithDefaultsValue(`{"a":1,"b":"value"}`),
+			expected: true,
+		},
+		"injected default": {
+			newValue: `{"a":1,"b":2}`,
+			prior:    NewJsonWithDefaultsValue(`{"a":1}`),
+			expected: true,
+		},
+		"injected nested default": {
+			newValue: `{"a":{"b":1,"c":true}}`,
+			prior:    NewJsonWithDefaultsValue(`{"a":{"b":1}}`),
+			expected: true,
+		},
+		"injected default into empty document": {
+			newValue: `{"a":1}`,
+			prior:    NewJsonWithDefaultsValue(`{}`),
+			expected: true,
+		},
+		"key removed by the user": {
+			newValue: `{"a":1}`,
+			prior:    NewJsonWithDefaultsValue(`{"a":1}`),
+			expected: true,
+		},
+		"key removed outside of terraform": {
+			newValue: `{"a":1}`,
+			prior:    NewJsonWithDefaultsValue(`{"a":1,"b":2}`),
+			expected: false,
+		},
+		"changed value": {
+			newValue: `{"a":2}`,
+			prior:    NewJsonWithDefaultsValue(`{"a":1}`),
+			expected: false,
+		},
+		"array": {
+			newValue: `{"a":[1,2,3]}`,
+			prior:    NewJsonWithDefaultsValue(`{"a":[1,2,3]}`),
+			expected: true,
+		},
+		"reordered array": {
+			newValue: `{"a":[3,2,1]}`,
+			prior:    NewJsonWithDefaultsValue(`{"a":[1,2,3]}`),
+			expected: false,
+		},
+		"array with added element": {
+			newValue: `{"a":[1,2,3]}`,
+			prior:    NewJsonWithDefaultsValue(`{"a":[1,2]}`),
+			expected: false,
+		},
+		"array objects with injected defaults": {
+			newValue: `{"a":[{"b":1,"c":2},{"b":3,"c":4}]}`,
+			prior:    NewJsonWithDefaultsValue(`{"a":[{"b":1},{"b":3}]}`),
+			expected: true,
+		},
+		"integer and float": {
+			newValue: `{"a":1.0}`,
+			prior:    NewJsonWithDefaultsValue(`{"a":1}`),
+			expected: true,
+		},
+		"different numbers": {
+			newValue: `{"a":1.5}`,
+			prior:    NewJsonWithDefaultsValue(`{"a":1}`),
+			expected: false,
+		},
+		"number and string": {
+			newValue: `{"a":"1"}`,
+			prior:    NewJsonWithDefaultsValue(`{"a":1}`),
+			expected: false,
+		},
+		"invalid json": {
+			newValue:      `{"a":`,
+			prior:         NewJsonWithDefaultsValue(`{"a":1}`),
+			expectedError: true,
+		},
+		"unexpected type": {
+			newValue:      `{"a":1}`,
+			prior:         basetypes.NewStringValue(`{"a":1}`),
+			expectedError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// The framework calls the method on the new value with the prior value as argument
+			equal, diags := NewJsonWithDefaultsValue(test.newValue).StringSemanticEquals(context.Background(), test.prior)
+			if diags.HasError() != test.expectedError {
+				t.Fatalf("expected error: %t, got diagnostics: %v", test.expectedError, diags)
+			}
+			if equal != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, equal)
+			}
+		})
+	}
+}
@@ -11,14 +11,19 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource                = &CustomRoleResource{}
-	_ resource.ResourceWithConfigure   = &CustomRoleResource{}
-	_ resource.ResourceWithImportState = &CustomRoleResource{}
+	_ resource.Resource                   = &CustomRoleResource{}
+	_ resource.ResourceWithConfigure      = &CustomRoleResource{}
+	_ resource.ResourceWithImportState    = &CustomRoleResource{}
+	_ resource.ResourceWithIdentity       = &CustomRoleResource{}
+	_ resource.ResourceWithValidateConfig = &CustomRoleResource{}
+	_ resource.ResourceWithUpgradeState   = &CustomRoleResource{}
 )
 
 type CustomRoleResource struct {
@@ -33,12 +38,26 @@ func (r *CustomRoleResource) Metadata(_ context.Context, req resource.MetadataRe
 	resp.TypeName = req.ProviderTypeName + "_custom_role"
 }
 
-func (r *CustomRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *CustomRoleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:    0,
 		Attributes: schemaAttributes.CustomRoleResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
+func (r *CustomRoleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.CustomRoleResourceIdentityAttributes,
+	}
+}
+
+func (r *CustomRoleResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r)
+}
+
 func (r *CustomRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring CustomRoleResource")
 
@@ -59,6 +78,35 @@ func (r *CustomRoleResource) Configure(ctx context.Context, req resource.Configu
 	tflog.Trace(ctx, "Configured CustomRoleResource")
 }
 
+func (r *CustomRoleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data dataModels.CustomRoleModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.GrantedRights.IsNull() || data.GrantedRights.IsUnknown() ||
+		data.DisallowedRights.IsNull() || data.DisallowedRights.IsUnknown() {
+		return
+	}
+
+	granted := make(map[string]bool)
+	for _, right := range data.GrantedRights.Elements() {
+		if value, ok := right.(types.String); ok && !value.IsUnknown() {
+			granted[value.ValueString()] = true
+		}
+	}
+	for _, right := range data.DisallowedRights.Elements() {
+		if value, ok := right.(types.String); ok && granted[value.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("disallowed_rights"),
+				"Conflicting Custom Role Rights",
+				fmt.Sprintf("The right %s can not be both granted and disallowed.", value.ValueString()),
+			)
+		}
+	}
+}
+
 func (r *CustomRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Trace(ctx, "Creating CustomRoleResource")
 
@@ -68,13 +116,16 @@ func (r *CustomRoleResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Convert to DTO
 	customRoleDto := CustomRoleModelToDto(ctx, &data)
 
 	// Create custom role
 	var customRoleCUDDto dto.CustomRoleCUDResponseDto
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl("/v1/roles").
 		Method(httpClient.POST).
 		SetBody(customRoleDto).
@@ -108,21 +159,27 @@ func (r *CustomRoleResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Update state with response
 	result := CustomRoleCUDDtoToModel(&customRoleCUDDto, &data)
+	result.Timeouts = data.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *CustomRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data dataModels.CustomRoleModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Trace(ctx, "Reading CustomRoleResource")
 
 	var customRoleDto dto.CustomRoleDto
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/roles/%s", data.ID.ValueString())).
 		Method(httpClient.GET).
 		SetBodyParseObject(&customRoleDto).
@@ -160,6 +217,7 @@ func (r *CustomRoleResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	result := CustomRoleDtoToModel(&customRoleDto)
+	result.Timeouts = data.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
 }
 
@@ -170,13 +228,16 @@ func (r *CustomRoleResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Convert to DTO
 	customRoleDto := CustomRoleModelToDto(ctx, &data)
 
 	// Update custom role
 	var customRoleCUDDto dto.CustomRoleCUDResponseDto
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/roles/%s", data.ID.ValueString())).
 		Method(httpClient.PUT).
 		SetBody(customRoleDto).
@@ -209,7 +270,9 @@ func (r *CustomRoleResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	result := CustomRoleCUDDtoToModel(&customRoleCUDDto, &data)
+	result.Timeouts = data.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *CustomRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -219,8 +282,11 @@ func (r *CustomRoleResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/roles/%s", data.ID.ValueString())).
 		Method(httpClient.DELETE).
 		Send()
@@ -252,5 +318,14 @@ func (r *CustomRoleResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *CustomRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		return
+	}
+
+	importNamedResource(ctx, req, resp, "custom-role", func(name string) (string, error) {
+		return findIdByName(ctx, r.clientConfiguration, "/v1/roles", nil, "custom role", name, func(role dto.CustomRoleDto) (string, string) {
+			return role.Name, role.ID
+		})
+	})
 }
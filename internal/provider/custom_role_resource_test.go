@@ -2,6 +2,7 @@ package provider
 
 import (
 	"github.com/google/uuid"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -80,3 +81,32 @@ resource "atlassian-operations_custom_role" "test" {
 		},
 	})
 }
+
+func TestAccCustomRoleResource_InvalidRights(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unknown right
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_custom_role" "test" {
+  name           = "Invalid role"
+  granted_rights = ["alert-make-coffee"]
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("value must be one of"),
+			},
+			// Right both granted and disallowed
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_custom_role" "test" {
+  name              = "Invalid role"
+  granted_rights    = ["alert-view", "alert-close"]
+  disallowed_rights = ["alert-close"]
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Conflicting Custom Role Rights"),
+			},
+		},
+	})
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CustomRoleRightsDataSource{}
+
+func NewCustomRoleRightsDataSource() datasource.DataSource {
+	return &CustomRoleRightsDataSource{}
+}
+
+// CustomRoleRightsDataSource exposes the catalog of custom role rights known to the provider.
+type CustomRoleRightsDataSource struct{}
+
+func (d *CustomRoleRightsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_role_rights"
+}
+
+func (d *CustomRoleRightsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Custom role rights data source. Lists the rights accepted by the granted_rights and disallowed_rights attributes of the atlassian-operations_custom_role resource.",
+		Attributes:          schemaAttributes.CustomRoleRightsDataSourceAttributes,
+	}
+}
+
+func (d *CustomRoleRightsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Trace(ctx, "Reading custom role rights data source")
+
+	rights := make([]attr.Value, len(dto.CustomRoleRights))
+	for i, right := range dto.CustomRoleRights {
+		rights[i] = types.StringValue(right)
+	}
+
+	model := dataModels.CustomRoleRightsModel{
+		Rights: types.SetValueMust(types.StringType, rights),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
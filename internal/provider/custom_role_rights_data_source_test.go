@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCustomRoleRightsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_custom_role_rights" "all" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.atlassian-operations_custom_role_rights.all", "rights.#", strconv.Itoa(len(dto.CustomRoleRights))),
+					resource.TestCheckTypeSetElemAttr("data.atlassian-operations_custom_role_rights.all", "rights.*", "alert-acknowledge"),
+					resource.TestCheckTypeSetElemAttr("data.atlassian-operations_custom_role_rights.all", "rights.*", "alert-view"),
+				),
+			},
+		},
+	})
+}
@@ -1,12 +1,18 @@
 package dataModels
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type CustomRoleModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	GrantedRights    types.Set    `tfsdk:"granted_rights"`
-	DisallowedRights types.Set    `tfsdk:"disallowed_rights"`
+	ID               types.String   `tfsdk:"id"`
+	Name             types.String   `tfsdk:"name"`
+	GrantedRights    types.Set      `tfsdk:"granted_rights"`
+	DisallowedRights types.Set      `tfsdk:"disallowed_rights"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+type CustomRoleRightsModel struct {
+	Rights types.Set `tfsdk:"rights"`
 }
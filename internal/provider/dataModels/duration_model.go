@@ -0,0 +1,23 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DurationModel struct {
+	WaitDuration   types.Int64  `tfsdk:"wait_duration"`
+	DurationFormat types.String `tfsdk:"duration_format"`
+}
+
+var DurationModelMap = map[string]attr.Type{
+	"wait_duration":   types.Int64Type,
+	"duration_format": types.StringType,
+}
+
+func (receiver *DurationModel) AsValue() types.Object {
+	return types.ObjectValueMust(DurationModelMap, map[string]attr.Value{
+		"wait_duration":   receiver.WaitDuration,
+		"duration_format": receiver.DurationFormat,
+	})
+}
@@ -1,21 +1,23 @@
 package dataModels
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type (
 	EmailIntegrationModel struct {
-		Id                          types.String `tfsdk:"id"`
-		Name                        types.String `tfsdk:"name"`
-		Enabled                     types.Bool   `tfsdk:"enabled"`
-		TeamId                      types.String `tfsdk:"team_id"`
-		Advanced                    types.Bool   `tfsdk:"advanced"`
-		Directions                  types.List   `tfsdk:"directions"`
-		Domains                     types.List   `tfsdk:"domains"`
-		MaintenanceSources          types.List   `tfsdk:"maintenance_sources"`
-		TypeSpecificPropertiesModel types.Object `tfsdk:"type_specific_properties"`
+		Id                          types.String   `tfsdk:"id"`
+		Name                        types.String   `tfsdk:"name"`
+		Enabled                     types.Bool     `tfsdk:"enabled"`
+		TeamId                      types.String   `tfsdk:"team_id"`
+		Advanced                    types.Bool     `tfsdk:"advanced"`
+		Directions                  types.List     `tfsdk:"directions"`
+		Domains                     types.List     `tfsdk:"domains"`
+		MaintenanceSources          types.List     `tfsdk:"maintenance_sources"`
+		TypeSpecificPropertiesModel types.Object   `tfsdk:"type_specific_properties"`
+		Timeouts                    timeouts.Value `tfsdk:"timeouts"`
 	}
 
 	TypeSpecificPropertiesModel struct {
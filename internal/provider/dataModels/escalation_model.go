@@ -1,19 +1,22 @@
 package dataModels
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type (
 	EscalationModel struct {
-		Id          types.String `tfsdk:"id"`
-		TeamId      types.String `tfsdk:"team_id"`
-		Name        types.String `tfsdk:"name"`
-		Description types.String `tfsdk:"description"`
-		Rules       types.Set    `tfsdk:"rules"`
-		Enabled     types.Bool   `tfsdk:"enabled"`
-		Repeat      types.Object `tfsdk:"repeat"`
+		Id                 types.String   `tfsdk:"id"`
+		TeamId             types.String   `tfsdk:"team_id"`
+		Name               types.String   `tfsdk:"name"`
+		Description        types.String   `tfsdk:"description"`
+		Rules              types.Set      `tfsdk:"rules"`
+		Enabled            types.Bool     `tfsdk:"enabled"`
+		Repeat             types.Object   `tfsdk:"repeat"`
+		DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+		Timeouts           timeouts.Value `tfsdk:"timeouts"`
 	}
 	EscalationRuleResponseModel struct {
 		Condition  types.String `tfsdk:"condition"`
@@ -31,6 +34,9 @@ type (
 		ResetRecipientStates types.Bool  `tfsdk:"reset_recipient_states"`
 		CloseAlertAfterAll   types.Bool  `tfsdk:"close_alert_after_all"`
 	}
+	EscalationListModel struct {
+		TeamId types.String `tfsdk:"team_id"`
+	}
 )
 
 var EscalationRuleResponseRecipientModelMap = map[string]attr.Type{
@@ -0,0 +1,37 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type IncomingCallRoutingModel struct {
+	ID              types.String   `tfsdk:"id"`
+	TeamID          types.String   `tfsdk:"team_id"`
+	Name            types.String   `tfsdk:"name"`
+	CountryCode     types.String   `tfsdk:"country_code"`
+	PhoneNumber     types.String   `tfsdk:"phone_number"`
+	GreetingMessage types.String   `tfsdk:"greeting_message"`
+	Voice           types.Object   `tfsdk:"voice"`
+	Recipients      types.List     `tfsdk:"recipients"`
+	Enabled         types.Bool     `tfsdk:"enabled"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+type IncomingCallRoutingVoiceModel struct {
+	Language types.String `tfsdk:"language"`
+	Gender   types.String `tfsdk:"gender"`
+}
+
+var IncomingCallRoutingVoiceModelMap = map[string]attr.Type{
+	"language": types.StringType,
+	"gender":   types.StringType,
+}
+
+func (receiver *IncomingCallRoutingVoiceModel) AsValue() types.Object {
+	return types.ObjectValueMust(IncomingCallRoutingVoiceModelMap, map[string]attr.Value{
+		"language": receiver.Language,
+		"gender":   receiver.Gender,
+	})
+}
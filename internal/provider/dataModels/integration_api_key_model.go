@@ -0,0 +1,10 @@
+package dataModels
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type (
+	IntegrationApiKeyModel struct {
+		IntegrationId types.String `tfsdk:"integration_id"`
+		ApiKey        types.String `tfsdk:"api_key"`
+	}
+)
@@ -1,19 +1,21 @@
 package dataModels
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // MaintenanceModel represents the Terraform resource data model for a maintenance window
 type MaintenanceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Description types.String `tfsdk:"description"`
-	StartDate   types.String `tfsdk:"start_date"`
-	EndDate     types.String `tfsdk:"end_date"`
-	Status      types.String `tfsdk:"status"`
-	TeamID      types.String `tfsdk:"team_id"`
-	Rules       types.List   `tfsdk:"rules"`
+	ID          types.String   `tfsdk:"id"`
+	Description types.String   `tfsdk:"description"`
+	StartDate   types.String   `tfsdk:"start_date"`
+	EndDate     types.String   `tfsdk:"end_date"`
+	Status      types.String   `tfsdk:"status"`
+	TeamID      types.String   `tfsdk:"team_id"`
+	Rules       types.List     `tfsdk:"rules"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 // MaintenanceRuleModel represents a rule within a maintenance window for Terraform
@@ -1,24 +1,26 @@
 package dataModels
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type NotificationPolicyModel struct {
-	ID                  types.String  `tfsdk:"id"`
-	Type                types.String  `tfsdk:"type"`
-	Name                types.String  `tfsdk:"name"`
-	Description         types.String  `tfsdk:"description"`
-	TeamID              types.String  `tfsdk:"team_id"`
-	Enabled             types.Bool    `tfsdk:"enabled"`
-	Order               types.Float64 `tfsdk:"order"`
-	Filter              types.Object  `tfsdk:"filter"`
-	TimeRestriction     types.Object  `tfsdk:"time_restriction"`
-	AutoRestartAction   types.Object  `tfsdk:"auto_restart_action"`
-	AutoCloseAction     types.Object  `tfsdk:"auto_close_action"`
-	DeduplicationAction types.Object  `tfsdk:"deduplication_action"`
-	DelayAction         types.Object  `tfsdk:"delay_action"`
-	Suppress            types.Bool    `tfsdk:"suppress"`
+	ID                  types.String   `tfsdk:"id"`
+	Type                types.String   `tfsdk:"type"`
+	Name                types.String   `tfsdk:"name"`
+	Description         types.String   `tfsdk:"description"`
+	TeamID              types.String   `tfsdk:"team_id"`
+	Enabled             types.Bool     `tfsdk:"enabled"`
+	Order               types.Float64  `tfsdk:"order"`
+	Filter              types.Object   `tfsdk:"filter"`
+	TimeRestriction     types.Object   `tfsdk:"time_restriction"`
+	AutoRestartAction   types.Object   `tfsdk:"auto_restart_action"`
+	AutoCloseAction     types.Object   `tfsdk:"auto_close_action"`
+	DeduplicationAction types.Object   `tfsdk:"deduplication_action"`
+	DelayAction         types.Object   `tfsdk:"delay_action"`
+	Suppress            types.Bool     `tfsdk:"suppress"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 type NotificationPolicyTimeRestrictionModel struct {
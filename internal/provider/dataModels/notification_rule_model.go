@@ -1,6 +1,8 @@
 package dataModels
 
 import (
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/customTypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -10,17 +12,19 @@ var (
 )
 
 type NotificationRuleModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	ActionType       types.String `tfsdk:"action_type"`
-	Criteria         types.Object `tfsdk:"criteria"`
-	NotificationTime types.Set    `tfsdk:"notification_time"`
-	TimeRestriction  types.Object `tfsdk:"time_restriction"`
-	Schedules        types.List   `tfsdk:"schedules"`
-	Order            types.Int64  `tfsdk:"order"`
-	Steps            types.List   `tfsdk:"steps"`
-	Repeat           types.Object `tfsdk:"repeat"`
-	Enabled          types.Bool   `tfsdk:"enabled"`
+	ID               types.String   `tfsdk:"id"`
+	UserId           types.String   `tfsdk:"user_id"`
+	Name             types.String   `tfsdk:"name"`
+	ActionType       types.String   `tfsdk:"action_type"`
+	Criteria         types.Object   `tfsdk:"criteria"`
+	NotificationTime types.Set      `tfsdk:"notification_time"`
+	TimeRestriction  types.Object   `tfsdk:"time_restriction"`
+	Schedules        types.List     `tfsdk:"schedules"`
+	Order            types.Int64    `tfsdk:"order"`
+	Steps            types.List     `tfsdk:"steps"`
+	Repeat           types.Object   `tfsdk:"repeat"`
+	Enabled          types.Bool     `tfsdk:"enabled"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (m NotificationRuleModel) GetType() string {
@@ -30,6 +34,7 @@ func (m NotificationRuleModel) GetType() string {
 func (m NotificationRuleModel) AsValue() types.Object {
 	return types.ObjectValueMust(NotificationRuleModelMap, map[string]attr.Value{
 		"id":                m.ID,
+		"user_id":           m.UserId,
 		"name":              m.Name,
 		"action_type":       m.ActionType,
 		"criteria":          m.Criteria,
@@ -78,8 +83,8 @@ func (s NotificationRuleStepModel) AsValue() types.Object {
 }
 
 type NotificationContactModel struct {
-	Method types.String `tfsdk:"method"`
-	To     types.String `tfsdk:"to"`
+	Method types.String               `tfsdk:"method"`
+	To     customTypes.ContactAddress `tfsdk:"to"`
 }
 
 func (c NotificationContactModel) AsValue() types.Object {
@@ -103,6 +108,7 @@ func (r NotificationRuleRepeatModel) AsValue() types.Object {
 
 var NotificationRuleModelMap = map[string]attr.Type{
 	"id":          types.StringType,
+	"user_id":     types.StringType,
 	"name":        types.StringType,
 	"action_type": types.StringType,
 	"criteria": types.ObjectType{
@@ -148,7 +154,7 @@ var NotificationRuleStepModelMap = map[string]attr.Type{
 
 var NotificationContactModelMap = map[string]attr.Type{
 	"method": types.StringType,
-	"to":     types.StringType,
+	"to":     customTypes.ContactAddressType{},
 }
 
 var NotificationRuleRepeatModelMap = map[string]attr.Type{
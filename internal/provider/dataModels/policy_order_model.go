@@ -0,0 +1,14 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PolicyOrderModel struct {
+	ID        types.String   `tfsdk:"id"`
+	TeamID    types.String   `tfsdk:"team_id"`
+	Type      types.String   `tfsdk:"type"`
+	PolicyIDs types.List     `tfsdk:"policy_ids"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
@@ -1,6 +1,7 @@
 package dataModels
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -17,6 +18,7 @@ type (
 		Length          types.Int32       `tfsdk:"length"`
 		Participants    types.List        `tfsdk:"participants"`
 		TimeRestriction types.Object      `tfsdk:"time_restriction"`
+		Timeouts        timeouts.Value    `tfsdk:"timeouts"`
 	}
 	ResponderInfoModel struct {
 		Id   types.String `tfsdk:"id"`
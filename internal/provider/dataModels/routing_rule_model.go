@@ -1,20 +1,22 @@
 package dataModels
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type RoutingRuleModel struct {
-	ID              types.String `tfsdk:"id"`
-	TeamID          types.String `tfsdk:"team_id"`
-	Name            types.String `tfsdk:"name"`
-	Order           types.Int64  `tfsdk:"order"`
-	IsDefault       types.Bool   `tfsdk:"is_default"`
-	Timezone        types.String `tfsdk:"timezone"`
-	Criteria        types.Object `tfsdk:"criteria"`
-	TimeRestriction types.Object `tfsdk:"time_restriction"`
-	Notify          types.Object `tfsdk:"notify"`
+	ID              types.String   `tfsdk:"id"`
+	TeamID          types.String   `tfsdk:"team_id"`
+	Name            types.String   `tfsdk:"name"`
+	Order           types.Int64    `tfsdk:"order"`
+	IsDefault       types.Bool     `tfsdk:"is_default"`
+	Timezone        types.String   `tfsdk:"timezone"`
+	Criteria        types.Object   `tfsdk:"criteria"`
+	TimeRestriction types.Object   `tfsdk:"time_restriction"`
+	Notify          types.Object   `tfsdk:"notify"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 type RoutingRuleNotifyModel struct {
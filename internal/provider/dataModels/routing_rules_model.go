@@ -0,0 +1,50 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RoutingRulesModel struct {
+	ID            types.String   `tfsdk:"id"`
+	TeamID        types.String   `tfsdk:"team_id"`
+	DefaultNotify types.Object   `tfsdk:"default_notify"`
+	Rules         types.List     `tfsdk:"rules"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+type RoutingRulesRuleModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Timezone        types.String `tfsdk:"timezone"`
+	Criteria        types.Object `tfsdk:"criteria"`
+	TimeRestriction types.Object `tfsdk:"time_restriction"`
+	Notify          types.Object `tfsdk:"notify"`
+}
+
+var RoutingRulesRuleModelMap = map[string]attr.Type{
+	"id":       types.StringType,
+	"name":     types.StringType,
+	"timezone": types.StringType,
+	"criteria": types.ObjectType{
+		AttrTypes: CriteriaModelMap,
+	},
+	"time_restriction": types.ObjectType{
+		AttrTypes: TimeRestrictionModelMap,
+	},
+	"notify": types.ObjectType{
+		AttrTypes: RoutingRuleNotifyModelMap,
+	},
+}
+
+func (receiver *RoutingRulesRuleModel) AsValue() types.Object {
+	return types.ObjectValueMust(RoutingRulesRuleModelMap, map[string]attr.Value{
+		"id":               receiver.ID,
+		"name":             receiver.Name,
+		"timezone":         receiver.Timezone,
+		"criteria":         receiver.Criteria,
+		"time_restriction": receiver.TimeRestriction,
+		"notify":           receiver.Notify,
+	})
+}
@@ -1,6 +1,7 @@
 package dataModels
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -14,6 +15,13 @@ type ScheduleModel struct {
 	TeamId      types.String `tfsdk:"team_id"`
 }
 
+// ScheduleResourceModel is the state of the schedule resource.
+type ScheduleResourceModel struct {
+	ScheduleModel
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
 var ScheduleModelMap = map[string]attr.Type{
 	"id":          types.StringType,
 	"name":        types.StringType,
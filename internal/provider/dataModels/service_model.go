@@ -1,6 +1,7 @@
 package dataModels
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -16,6 +17,13 @@ type ServiceModel struct {
 	Responders      types.Object `tfsdk:"responders"`
 	Stakeholders    types.Object `tfsdk:"stakeholders"`
 	Projects        types.Object `tfsdk:"projects"`
+	Links           types.List   `tfsdk:"links"`
+}
+
+// ServiceResourceModel adds the timeouts block of the service resource to ServiceModel.
+type ServiceResourceModel struct {
+	ServiceModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type ChangeApproversModel struct {
@@ -35,6 +43,12 @@ type ProjectsModel struct {
 	IDs types.List `tfsdk:"ids"`
 }
 
+type ServiceLinkModel struct {
+	Type types.String `tfsdk:"type"`
+	Url  types.String `tfsdk:"url"`
+	Name types.String `tfsdk:"name"`
+}
+
 var ChangeApproversModelMap = map[string]attr.Type{
 	"groups": types.ListType{ElemType: types.StringType},
 }
@@ -52,6 +66,12 @@ var ProjectsModelMap = map[string]attr.Type{
 	"ids": types.ListType{ElemType: types.StringType},
 }
 
+var ServiceLinkModelMap = map[string]attr.Type{
+	"type": types.StringType,
+	"url":  types.StringType,
+	"name": types.StringType,
+}
+
 var ServiceModelMap = map[string]attr.Type{
 	"id":               types.StringType,
 	"name":             types.StringType,
@@ -63,6 +83,7 @@ var ServiceModelMap = map[string]attr.Type{
 	"responders":       types.ObjectType{AttrTypes: RespondersModelMap},
 	"stakeholders":     types.ObjectType{AttrTypes: StakeholdersModelMap},
 	"projects":         types.ObjectType{AttrTypes: ProjectsModelMap},
+	"links":            types.ListType{ElemType: types.ObjectType{AttrTypes: ServiceLinkModelMap}},
 }
 
 func (m *ServiceModel) AsValue() types.Object {
@@ -77,5 +98,12 @@ func (m *ServiceModel) AsValue() types.Object {
 		"responders":       m.Responders,
 		"stakeholders":     m.Stakeholders,
 		"projects":         m.Projects,
+		"links":            m.Links,
 	})
 }
+
+type ServicesModel struct {
+	Owner    types.String `tfsdk:"owner"`
+	Tier     types.Int32  `tfsdk:"tier"`
+	Services types.List   `tfsdk:"services"`
+}
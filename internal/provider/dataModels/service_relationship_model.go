@@ -0,0 +1,14 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ServiceRelationshipModel struct {
+	ID              types.String   `tfsdk:"id"`
+	SourceServiceId types.String   `tfsdk:"source_service_id"`
+	TargetServiceId types.String   `tfsdk:"target_service_id"`
+	Type            types.String   `tfsdk:"type"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
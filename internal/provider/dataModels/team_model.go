@@ -1,6 +1,7 @@
 package dataModels
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -26,8 +27,19 @@ type (
 	TeamMemberModel struct {
 		AccountId types.String `tfsdk:"account_id"`
 	}
+	TeamListModel struct {
+		OrganizationId types.String `tfsdk:"organization_id"`
+	}
 )
 
+// TeamResourceModel extends TeamModel, which the team data source shares, with
+// the operation timeouts and deletion protection of the team resource.
+type TeamResourceModel struct {
+	TeamModel
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
 var TeamModelMap = map[string]attr.Type{
 	"description":     types.StringType,
 	"display_name":    types.StringType,
@@ -0,0 +1,23 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TeamRoleModel struct {
+	ID               types.String   `tfsdk:"id"`
+	TeamID           types.String   `tfsdk:"team_id"`
+	Name             types.String   `tfsdk:"name"`
+	GrantedRights    types.Set      `tfsdk:"granted_rights"`
+	DisallowedRights types.Set      `tfsdk:"disallowed_rights"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+type TeamMemberRoleModel struct {
+	ID        types.String   `tfsdk:"id"`
+	TeamID    types.String   `tfsdk:"team_id"`
+	AccountId types.String   `tfsdk:"account_id"`
+	Role      types.String   `tfsdk:"role"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
@@ -1,27 +1,33 @@
 package dataModels
 
 import (
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/customTypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type UserContactModel struct {
-	ID      types.String `tfsdk:"id"`
-	Method  types.String `tfsdk:"method"`
-	To      types.String `tfsdk:"to"`
-	Enabled types.Bool   `tfsdk:"enabled"`
+	ID       types.String               `tfsdk:"id"`
+	UserId   types.String               `tfsdk:"user_id"`
+	Method   types.String               `tfsdk:"method"`
+	To       customTypes.ContactAddress `tfsdk:"to"`
+	Enabled  types.Bool                 `tfsdk:"enabled"`
+	Timeouts timeouts.Value             `tfsdk:"timeouts"`
 }
 
 var UserContactModelMap = map[string]attr.Type{
 	"id":      types.StringType,
+	"user_id": types.StringType,
 	"method":  types.StringType,
-	"to":      types.StringType,
+	"to":      customTypes.ContactAddressType{},
 	"enabled": types.BoolType,
 }
 
 func (receiver *UserContactModel) AsValue() types.Object {
 	return types.ObjectValueMust(UserContactModelMap, map[string]attr.Value{
 		"id":      receiver.ID,
+		"user_id": receiver.UserId,
 		"method":  receiver.Method,
 		"to":      receiver.To,
 		"enabled": receiver.Enabled,
@@ -0,0 +1,23 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type UserQuietHoursModel struct {
+	ID              types.String   `tfsdk:"id"`
+	Enabled         types.Bool     `tfsdk:"enabled"`
+	TimeRestriction types.Object   `tfsdk:"time_restriction"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+type UserForwardingRuleModel struct {
+	ID         types.String      `tfsdk:"id"`
+	FromUserId types.String      `tfsdk:"from_user_id"`
+	ToUserId   types.String      `tfsdk:"to_user_id"`
+	StartDate  timetypes.RFC3339 `tfsdk:"start_date"`
+	EndDate    timetypes.RFC3339 `tfsdk:"end_date"`
+	Timeouts   timeouts.Value    `tfsdk:"timeouts"`
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// addDeletionProtection is the state upgrade step of the schema version introducing deletion_protection, existing
+// resources stay unprotected.
+func addDeletionProtection(attributes map[string]interface{}) error {
+	attributes["deletion_protection"] = false
+	return nil
+}
+
+// deletionProtectionOrDefault returns the deletion protection read from the state, which is null for imported and
+// listed resources. Those are unprotected, as after creating them without setting deletion_protection.
+func deletionProtectionOrDefault(deletionProtection types.Bool) types.Bool {
+	if deletionProtection.IsNull() || deletionProtection.IsUnknown() {
+		return types.BoolValue(false)
+	}
+	return deletionProtection
+}
+
+// checkDeletionProtection prevents destroying a resource with deletion_protection enabled in its state. Destroying
+// it requires applying deletion_protection = false first, so removing the resource from the configuration alone
+// never destroys it.
+func checkDeletionProtection(deletionProtection types.Bool, resourceName string, id string, diags *diag.Diagnostics) {
+	if !deletionProtection.ValueBool() {
+		return
+	}
+
+	diags.AddError(
+		"Deletion Protection Enabled",
+		fmt.Sprintf("The %s %s can not be destroyed while deletion_protection is enabled. Set deletion_protection to false and apply the configuration before destroying it.", resourceName, id),
+	)
+}
+
+// checkReplacementProtection reports a plan replacing a resource with deletion_protection enabled in its state at
+// plan time. With create_before_destroy, failing in Delete would only happen after the replacement was created.
+// replacementAttributes are the string attributes of the resource whose plan modifiers force a replacement, the
+// framework does not pass the replacements planned by attribute plan modifiers to ModifyPlan.
+func checkReplacementProtection(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, resourceName string, replacementAttributes ...path.Path) {
+	// Only updates can require a replacement
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var deletionProtection types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)...)
+	if !deletionProtection.ValueBool() {
+		return
+	}
+
+	var changed []string
+	for _, attributePath := range replacementAttributes {
+		var stateValue, planValue types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, attributePath, &stateValue)...)
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, attributePath, &planValue)...)
+		if !planValue.Equal(stateValue) {
+			changed = append(changed, attributePath.String())
+		}
+	}
+	if len(changed) == 0 {
+		return
+	}
+
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.AddError(
+		"Deletion Protection Enabled",
+		fmt.Sprintf("The %s %s can not be replaced while deletion_protection is enabled. Changing %s requires replacing it, set deletion_protection to false and apply the configuration first.", resourceName, id.ValueString(), strings.Join(changed, ", ")),
+	)
+}
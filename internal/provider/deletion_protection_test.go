@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestDeletionProtection destroys the state fixtures of the protected resources with deletion_protection enabled,
+// which must fail before the API is called. Destroying and replacing both go through Delete.
+func TestDeletionProtection(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]func() resource.Resource{
+		"team":            NewTeamResource,
+		"schedule":        NewScheduleResource,
+		"escalation":      NewEscalationResource,
+		"api_integration": NewApiIntegrationResource,
+	}
+
+	for name, newResource := range tests {
+		t.Run(name, func(t *testing.T) {
+			res := newResource()
+
+			var schemaResp resource.SchemaResponse
+			res.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+			unprotected, err := (&tfprotov6.RawState{JSON: readStateUpgradeFixture(t, name, schemaResp.Schema.Version)}).Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+			if err != nil {
+				t.Fatalf("fixture does not match the current schema: %s", err)
+			}
+			protected := withStateAttribute(t, unprotected, "deletion_protection", tftypes.NewValue(tftypes.Bool, true))
+
+			req := resource.DeleteRequest{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: protected},
+			}
+			resp := resource.DeleteResponse{
+				State: req.State,
+			}
+
+			res.Delete(ctx, req, &resp)
+			if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Deletion Protection Enabled" {
+				t.Errorf("expected the deletion protection error, got diagnostics: %v", resp.Diagnostics)
+			}
+			if !resp.State.Raw.Equal(protected) {
+				t.Error("expected the protected resource to remain in the state")
+			}
+		})
+	}
+}
+
+// TestReplacementProtection plans changes to the state fixtures of the protected resources through the provider
+// server, so the plan modifiers of the schema decide whether the change replaces the resource.
+func TestReplacementProtection(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		resourceName        string
+		newResource         func() resource.Resource
+		attribute           string
+		deletionProtection  bool
+		expectedReplacement bool
+		expectedError       bool
+	}{
+		"replacing protected escalation": {
+			resourceName:        "escalation",
+			newResource:         NewEscalationResource,
+			attribute:           "team_id",
+			deletionProtection:  true,
+			expectedReplacement: true,
+			expectedError:       true,
+		},
+		"replacing unprotected escalation": {
+			resourceName:        "escalation",
+			newResource:         NewEscalationResource,
+			attribute:           "team_id",
+			expectedReplacement: true,
+		},
+		"updating protected escalation": {
+			resourceName:       "escalation",
+			newResource:        NewEscalationResource,
+			attribute:          "name",
+			deletionProtection: true,
+		},
+		"replacing protected api integration by type": {
+			resourceName:        "api_integration",
+			newResource:         NewApiIntegrationResource,
+			attribute:           "type",
+			deletionProtection:  true,
+			expectedReplacement: true,
+			expectedError:       true,
+		},
+		"replacing protected api integration by team": {
+			resourceName:        "api_integration",
+			newResource:         NewApiIntegrationResource,
+			attribute:           "team_id",
+			deletionProtection:  true,
+			expectedReplacement: true,
+			expectedError:       true,
+		},
+		"replacing unprotected api integration": {
+			resourceName:        "api_integration",
+			newResource:         NewApiIntegrationResource,
+			attribute:           "type",
+			expectedReplacement: true,
+		},
+		"updating protected api integration": {
+			resourceName:       "api_integration",
+			newResource:        NewApiIntegrationResource,
+			attribute:          "name",
+			deletionProtection: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server, err := providerserver.NewProtocol6WithError(New("test")())()
+			if err != nil {
+				t.Fatalf("unable to create the provider server: %s", err)
+			}
+
+			state := currentStateFixture(t, ctx, test.newResource(), test.resourceName)
+			stateType := state.Schema.Type().TerraformType(ctx)
+			prior := withStateAttribute(t, state.Raw, "deletion_protection", tftypes.NewValue(tftypes.Bool, test.deletionProtection))
+			proposed := withStateAttribute(t, prior, test.attribute, tftypes.NewValue(tftypes.String, "changed"))
+
+			priorState, _ := tfprotov6.NewDynamicValue(stateType, prior)
+			proposedState, _ := tfprotov6.NewDynamicValue(stateType, proposed)
+			resp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+				TypeName:         "atlassian-operations_" + test.resourceName,
+				PriorState:       &priorState,
+				ProposedNewState: &proposedState,
+				Config:           &proposedState,
+			})
+			if err != nil {
+				t.Fatalf("unable to plan: %s", err)
+			}
+
+			var hasError bool
+			for _, diagnostic := range resp.Diagnostics {
+				if diagnostic.Severity != tfprotov6.DiagnosticSeverityError {
+					continue
+				}
+				if diagnostic.Summary != "Deletion Protection Enabled" {
+					t.Fatalf("unexpected diagnostic: %s: %s", diagnostic.Summary, diagnostic.Detail)
+				}
+				hasError = true
+			}
+			if hasError != test.expectedError {
+				t.Errorf("expected the deletion protection error: %t, got %t", test.expectedError, hasError)
+			}
+
+			replaced := len(resp.RequiresReplace) == 1 && resp.RequiresReplace[0].Equal(tftypes.NewAttributePath().WithAttributeName(test.attribute))
+			if !hasError && replaced != test.expectedReplacement {
+				t.Errorf("expected replacement: %t, got RequiresReplace %v", test.expectedReplacement, resp.RequiresReplace)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &DurationFunction{}
+
+// Duration formats accepted by policy actions, from the largest to the smallest unit
+var durationFormats = []struct {
+	name string
+	unit time.Duration
+}{
+	{"days", 24 * time.Hour},
+	{"hours", time.Hour},
+	{"minutes", time.Minute},
+	{"seconds", time.Second},
+	{"millis", time.Millisecond},
+	{"micros", time.Microsecond},
+	{"nanos", time.Nanosecond},
+}
+
+// Go durations do not support days, they are accepted as a leading component such as 1d12h
+var durationDaysRegex = regexp.MustCompile(`^(\d+)d(.*)$`)
+
+type DurationFunction struct{}
+
+func NewDurationFunction() function.Function {
+	return &DurationFunction{}
+}
+
+func (f *DurationFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "duration"
+}
+
+func (f *DurationFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts a duration string to a wait_duration and duration_format pair",
+		Description: "Returns an object with the wait_duration and duration_format attributes of notification policy " +
+			"actions, using the largest duration format the duration is a whole multiple of. For example, '2h30m' " +
+			"returns a wait_duration of 150 with a duration_format of 'minutes'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "duration",
+				Description: "A positive duration such as '90s', '2h30m' or '1d12h'. Supported units are 'd', 'h', " +
+					"'m', 's', 'ms', 'us' and 'ns'.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: dataModels.DurationModelMap,
+		},
+	}
+}
+
+func (f *DurationFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	duration, err := parseDuration(value)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	for _, format := range durationFormats {
+		if duration%format.unit == 0 {
+			model := dataModels.DurationModel{
+				WaitDuration:   types.Int64Value(int64(duration / format.unit)),
+				DurationFormat: types.StringValue(format.name),
+			}
+			resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, model.AsValue()))
+			return
+		}
+	}
+}
+
+// parseDuration parses a positive Go duration, optionally prefixed with a number of days
+func parseDuration(value string) (time.Duration, error) {
+	var days time.Duration
+	rest := value
+	if match := durationDaysRegex.FindStringSubmatch(value); match != nil {
+		count, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid number of days in %q", value)
+		}
+		days = time.Duration(count) * 24 * time.Hour
+		rest = match[2]
+	}
+
+	var duration time.Duration
+	if rest != "" {
+		var err error
+		if duration, err = time.ParseDuration(rest); err != nil {
+			return 0, fmt.Errorf("invalid duration %q, expected e.g. '90s', '2h30m' or '1d12h'", value)
+		}
+	}
+
+	if duration < 0 || duration+days <= 0 {
+		return 0, fmt.Errorf("invalid duration %q, the duration must be positive", value)
+	}
+	return duration + days, nil
+}
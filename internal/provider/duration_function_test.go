@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccDurationFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+output "minutes" {
+  value = provider::atlassian-operations::duration("2h30m")
+}
+
+output "days" {
+  value = provider::atlassian-operations::duration("1d24h")
+}
+
+output "seconds" {
+  value = provider::atlassian-operations::duration("90s")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("minutes", durationCheck(150, "minutes")),
+					statecheck.ExpectKnownOutputValue("days", durationCheck(2, "days")),
+					statecheck.ExpectKnownOutputValue("seconds", durationCheck(90, "seconds")),
+				},
+			},
+			{
+				Config: providerConfig + `
+output "invalid" {
+  value = provider::atlassian-operations::duration("0m")
+}
+`,
+				ExpectError: regexp.MustCompile(`invalid duration "0m"`),
+			},
+		},
+	})
+}
+
+func durationCheck(waitDuration int64, durationFormat string) knownvalue.Check {
+	return knownvalue.ObjectExact(map[string]knownvalue.Check{
+		"wait_duration":   knownvalue.Int64Exact(waitDuration),
+		"duration_format": knownvalue.StringExact(durationFormat),
+	})
+}
@@ -11,8 +11,8 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -20,6 +20,9 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &EmailIntegrationResource{}
 var _ resource.ResourceWithImportState = &EmailIntegrationResource{}
+var _ resource.ResourceWithIdentity = &EmailIntegrationResource{}
+var _ resource.ResourceWithUpgradeState = &EmailIntegrationResource{}
+var _ resource.ResourceWithMoveState = &EmailIntegrationResource{}
 
 func NewEmailIntegrationResource() resource.Resource {
 	return &EmailIntegrationResource{}
@@ -34,9 +37,29 @@ func (r *EmailIntegrationResource) Metadata(_ context.Context, req resource.Meta
 	resp.TypeName = req.ProviderTypeName + "_email_integration"
 }
 
-func (r *EmailIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *EmailIntegrationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:    0,
 		Attributes: schemaAttributes.EmailIntegrationResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *EmailIntegrationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.EmailIntegrationResourceIdentityAttributes,
+	}
+}
+
+func (r *EmailIntegrationResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r)
+}
+
+func (r *EmailIntegrationResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		integrationStateMover(ctx, NewApiIntegrationResource(), "atlassian-operations_api_integration", moveApiIntegrationToEmailIntegration),
 	}
 }
 
@@ -71,10 +94,14 @@ func (r *EmailIntegrationResource) Create(ctx context.Context, req resource.Crea
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	emailIntegrationModelToDto := EmailIntegrationModelToDto(ctx, data)
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl("v1/integrations").
 		Method(httpClient.POST).
 		SetBody(emailIntegrationModelToDto).
@@ -109,7 +136,9 @@ func (r *EmailIntegrationResource) Create(ctx context.Context, req resource.Crea
 	tflog.Trace(ctx, "Created the EmailIntegrationResource")
 
 	// Save data into Terraform state
+	data.Timeouts = timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 	tflog.Trace(ctx, "Saved the EmailIntegrationResource into Terraform state")
 }
 
@@ -117,13 +146,18 @@ func (r *EmailIntegrationResource) Read(ctx context.Context, req resource.ReadRe
 	var data dataModels.EmailIntegrationModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
+
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Read, &resp.Diagnostics)
+	defer cancel()
 
 	tflog.Trace(ctx, "Reading the EmailIntegrationResource")
 
 	emailIntegration := dto.EmailIntegration{}
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("v1/integrations/%s", data.Id.ValueString())).
 		Method(httpClient.GET).
 		SetBodyParseObject(&emailIntegration).
@@ -160,6 +194,7 @@ func (r *EmailIntegrationResource) Read(ctx context.Context, req resource.ReadRe
 
 	tflog.Trace(ctx, "Read the EmailIntegrationResource")
 
+	data.Timeouts = timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	tflog.Trace(ctx, "Saved the EmailIntegrationResource into Terraform state")
 }
@@ -170,12 +205,16 @@ func (r *EmailIntegrationResource) Update(ctx context.Context, req resource.Upda
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Trace(ctx, "Updating the EmailIntegrationResource")
 
 	email := EmailIntegrationModelToDto(ctx, data)
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("v1/integrations/%s", data.Id.ValueString())).
 		Method(httpClient.PATCH).
 		SetBody(email).
@@ -213,7 +252,9 @@ func (r *EmailIntegrationResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	data.Timeouts = timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 	tflog.Trace(ctx, "Saved the EmailIntegrationResource into Terraform state")
 }
 
@@ -223,10 +264,13 @@ func (r *EmailIntegrationResource) Delete(ctx context.Context, req resource.Dele
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Trace(ctx, "Deleting the EmailIntegrationResource")
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("v1/integrations/%s", data.Id.ValueString())).
 		Method(httpClient.DELETE).
 		Send()
@@ -257,5 +301,12 @@ func (r *EmailIntegrationResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *EmailIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		return
+	}
+
+	importNamedResource(ctx, req, resp, "integration", func(name string) (string, error) {
+		return resolveIntegrationId(ctx, r.clientConfiguration, name)
+	})
 }
@@ -2,7 +2,11 @@ package provider
 
 import (
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"os"
 	"testing"
 
@@ -120,3 +124,102 @@ resource "atlassian-operations_email_integration" "example" {
 		},
 	})
 }
+
+func TestAccEmailIntegrationResource_Move(t *testing.T) {
+	emailIntegrationName := uuid.NewString()
+	randomEmailUsername := uuid.NewString()
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	teamConfig := providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  display_name = "` + teamName + `"
+  description = "team description"
+  organization_id = "` + organizationId + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+       account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+`
+	emailIntegrationConfig := teamConfig + `
+resource "atlassian-operations_email_integration" "example" {
+  name    = "` + emailIntegrationName + `"
+  team_id = atlassian-operations_team.example.id
+  enabled = true
+  type_specific_properties = {
+    email_username = "` + randomEmailUsername + `"
+    suppress_notifications = true
+  }
+}
+`
+	// Moving between the integration resources must keep the integration, and therefore its ID
+	sameId := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: emailIntegrationConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameId.AddStateValue("atlassian-operations_email_integration.example", tfjsonpath.New("id")),
+				},
+			},
+			// Move to an API integration
+			{
+				Config: teamConfig + `
+moved {
+  from = atlassian-operations_email_integration.example
+  to   = atlassian-operations_api_integration.example
+}
+
+resource "atlassian-operations_api_integration" "example" {
+  name    = "` + emailIntegrationName + `"
+  type    = "Email"
+  team_id = atlassian-operations_team.example.id
+  enabled = true
+  type_specific_properties = jsonencode({
+    emailUsername         = "` + randomEmailUsername + `"
+    suppressNotifications = true
+  })
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameId.AddStateValue("atlassian-operations_api_integration.example", tfjsonpath.New("id")),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_api_integration.example", "type", "Email"),
+					resource.TestCheckResourceAttr("atlassian-operations_api_integration.example", "name", emailIntegrationName),
+				),
+			},
+			// Move back to an email integration
+			{
+				Config: emailIntegrationConfig + `
+moved {
+  from = atlassian-operations_api_integration.example
+  to   = atlassian-operations_email_integration.example
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameId.AddStateValue("atlassian-operations_email_integration.example", tfjsonpath.New("id")),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_email_integration.example", "type_specific_properties.email_username", randomEmailUsername),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &EscalationListResource{}
+	_ list.ListResourceWithConfigure = &EscalationListResource{}
+)
+
+func NewEscalationListResource() list.ListResource {
+	return &EscalationListResource{}
+}
+
+// EscalationListResource defines the list resource implementation.
+type EscalationListResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (r *EscalationListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_escalation"
+}
+
+func (r *EscalationListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the escalation policies of a team.",
+		Attributes:  schemaAttributes.EscalationListResourceAttributes,
+	}
+}
+
+func (r *EscalationListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring EscalationListResource")
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Unexpected List Resource Configure Type")
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+
+	tflog.Trace(ctx, "Configured EscalationListResource")
+}
+
+func (r *EscalationListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Trace(ctx, "Listing the EscalationListResource")
+
+	var data dataModels.EscalationListModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	teamId := data.TeamId.ValueString()
+	stream.Results = listResults(ctx, r.clientConfiguration, req, NewEscalationResource, fmt.Sprintf("/v1/teams/%s/escalations", teamId), nil, "escalation", func(escalation dto.EscalationDto) (string, map[string]string, bool) {
+		return escalation.Name, map[string]string{"id": escalation.Id, "team_id": teamId}, true
+	})
+}
@@ -13,14 +13,18 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"strings"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &EscalationResource{}
 var _ resource.ResourceWithImportState = &EscalationResource{}
+var _ resource.ResourceWithIdentity = &EscalationResource{}
+var _ resource.ResourceWithUpgradeState = &EscalationResource{}
+var _ resource.ResourceWithModifyPlan = &EscalationResource{}
+var _ resource.ResourceWithValidateConfig = &EscalationResource{}
 
 func NewEscalationResource() resource.Resource {
 	return &EscalationResource{}
@@ -35,12 +39,26 @@ func (r *EscalationResource) Metadata(_ context.Context, req resource.MetadataRe
 	resp.TypeName = req.ProviderTypeName + "_escalation"
 }
 
-func (r *EscalationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *EscalationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:    1,
 		Attributes: schemaAttributes.EscalationResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
+func (r *EscalationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.EscalationResourceIdentityAttributes,
+	}
+}
+
+func (r *EscalationResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r, addDeletionProtection)
+}
+
 func (r *EscalationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring EscalationResource")
 
@@ -65,6 +83,37 @@ func (r *EscalationResource) Configure(ctx context.Context, req resource.Configu
 	tflog.Trace(ctx, "Configured EscalationResource")
 }
 
+func (r *EscalationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data dataModels.EscalationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Rules.IsNull() || data.Rules.IsUnknown() {
+		return
+	}
+
+	var rules []dataModels.EscalationRuleResponseModel
+	resp.Diagnostics.Append(data.Rules.ElementsAs(ctx, &rules, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	delays := make(map[string]bool)
+	for _, rule := range rules {
+		if rule.Condition.IsNull() || rule.Condition.IsUnknown() || rule.Delay.IsNull() || rule.Delay.IsUnknown() {
+			continue
+		}
+		key := fmt.Sprintf("%s/%d", rule.Condition.ValueString(), rule.Delay.ValueInt64())
+		if delays[key] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rules"),
+				"Duplicate Escalation Delay",
+				fmt.Sprintf("More than one rule with condition '%s' has a delay of %d minutes. Each rule of a condition must use a distinct delay.", rule.Condition.ValueString(), rule.Delay.ValueInt64()),
+			)
+			continue
+		}
+		delays[key] = true
+	}
+}
+
 func (r *EscalationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Trace(ctx, "Creating the EscalationResource")
 
@@ -72,10 +121,15 @@ func (r *EscalationResource) Create(ctx context.Context, req resource.CreateRequ
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	timeouts := data.Timeouts
+	deletionProtection := data.DeletionProtection
+	ctx, cancel := withTimeout(ctx, timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	escalationDto := EscalationModelToDto(ctx, data)
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/escalations", data.TeamId.ValueString())).
 		Method(httpClient.POST).
 		SetBody(escalationDto).
@@ -110,7 +164,10 @@ func (r *EscalationResource) Create(ctx context.Context, req resource.CreateRequ
 	tflog.Trace(ctx, "Created the EscalationResource")
 
 	// Save data into Terraform state
+	data.Timeouts = timeouts
+	data.DeletionProtection = deletionProtection
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 	tflog.Trace(ctx, "Saved the EscalationResource into Terraform state")
 }
 
@@ -118,13 +175,19 @@ func (r *EscalationResource) Read(ctx context.Context, req resource.ReadRequest,
 	var data dataModels.EscalationModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
+
+	timeouts := data.Timeouts
+	deletionProtection := data.DeletionProtection
+	ctx, cancel := withTimeout(ctx, timeouts.Read, &resp.Diagnostics)
+	defer cancel()
 
 	tflog.Trace(ctx, "Reading the EscalationResource")
 
 	escalationDto := dto.EscalationDto{}
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/escalations/%s", data.TeamId.ValueString(), data.Id.ValueString())).
 		Method(httpClient.GET).
 		SetBodyParseObject(&escalationDto).
@@ -161,6 +224,8 @@ func (r *EscalationResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	tflog.Trace(ctx, "Read the EscalationResource")
 
+	data.Timeouts = timeouts
+	data.DeletionProtection = deletionProtectionOrDefault(deletionProtection)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	tflog.Trace(ctx, "Saved the EscalationResource into Terraform state")
 }
@@ -171,12 +236,17 @@ func (r *EscalationResource) Update(ctx context.Context, req resource.UpdateRequ
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	timeouts := data.Timeouts
+	deletionProtection := data.DeletionProtection
+	ctx, cancel := withTimeout(ctx, timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Trace(ctx, "Updating the EscalationResource")
 
 	escalationDto := EscalationModelToDto(ctx, data)
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/escalations/%s", data.TeamId.ValueString(), data.Id.ValueString())).
 		Method(httpClient.PATCH).
 		SetBody(escalationDto).
@@ -214,7 +284,10 @@ func (r *EscalationResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	data.Timeouts = timeouts
+	data.DeletionProtection = deletionProtection
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 	tflog.Trace(ctx, "Saved the EscalationResource into Terraform state")
 }
 
@@ -224,10 +297,18 @@ func (r *EscalationResource) Delete(ctx context.Context, req resource.DeleteRequ
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	checkDeletionProtection(data.DeletionProtection, "escalation", data.Id.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Trace(ctx, "Deleting the EscalationResource")
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/escalations/%s", data.TeamId.ValueString(), data.Id.ValueString())).
 		Method(httpClient.DELETE).
 		Send()
@@ -257,15 +338,19 @@ func (r *EscalationResource) Delete(ctx context.Context, req resource.DeleteRequ
 	tflog.Trace(ctx, "Deleted the EscalationResource")
 }
 
+func (r *EscalationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkReplacementProtection(ctx, req, resp, "escalation", path.Root("team_id"))
+}
+
 func (r *EscalationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: id,team_id. Got: %q", req.ID),
-		)
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
 		return
 	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), idParts[1])...)
+
+	importTeamScopedResource(ctx, r.clientConfiguration, req, resp, "escalation", func(teamId string) string {
+		return fmt.Sprintf("/v1/teams/%s/escalations", teamId)
+	}, nil, func(escalation dto.EscalationDto) (string, string) {
+		return escalation.Name, escalation.Id
+	})
 }
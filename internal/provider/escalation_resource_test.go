@@ -3,8 +3,12 @@ package provider
 import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"os"
+	"regexp"
 	"testing"
 )
 
@@ -117,6 +121,13 @@ resource "atlassian-operations_escalation" "example" {
 						nil
 				},
 			},
+			// ImportState testing by name
+			{
+				ResourceName:      "atlassian-operations_escalation.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "team:" + teamName + "/escalation:" + escalationName,
+			},
 			// Update and Read testing
 			{
 				ExpectNonEmptyPlan: true,
@@ -313,3 +324,110 @@ resource "atlassian-operations_escalation" "example" {
 		},
 	})
 }
+
+func TestAccEscalationResource_DuplicateDelays(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_escalation" "example" {
+  name    = "escalation"
+  team_id = "00000000-0000-0000-0000-000000000000"
+  rules = [{
+	condition = "if-not-acked"
+	notify_type = "default"
+	delay = 5
+	recipient = {
+		id = "00000000-0000-0000-0000-000000000001"
+		type = "user"
+	}
+  },
+  {
+	condition = "if-not-acked"
+	notify_type = "all"
+	delay = 5
+	recipient = {
+		id = "00000000-0000-0000-0000-000000000000"
+		type = "team"
+	}
+  }]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Duplicate Escalation Delay"),
+			},
+		},
+	})
+}
+
+func TestAccEscalationResource_Identity(t *testing.T) {
+	escalationName := uuid.NewString()
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_escalation" "example" {
+  name    = "` + escalationName + `"
+  team_id = atlassian-operations_team.example.id
+  rules = [{
+	condition = "if-not-acked"
+	notify_type = "default"
+    delay = 5
+    recipient = {
+    	id = data.atlassian-operations_user.test1.account_id
+		type = "user"
+    }
+  }]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("atlassian-operations_escalation.example", tfjsonpath.New("id")),
+					statecheck.ExpectIdentityValueMatchesState("atlassian-operations_escalation.example", tfjsonpath.New("team_id")),
+					statecheck.ExpectIdentityValueMatchesState("atlassian-operations_team.example", tfjsonpath.New("id")),
+					statecheck.ExpectIdentityValueMatchesState("atlassian-operations_team.example", tfjsonpath.New("organization_id")),
+				},
+			},
+			// Import by resource identity testing
+			{
+				ResourceName:    "atlassian-operations_escalation.example",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
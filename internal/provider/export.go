@@ -0,0 +1,466 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Exporter walks the resources of an existing tenant and renders them as Terraform configuration, together with the
+// import blocks that adopt them into state. Resources are read through the same ImportState and Read methods that
+// back terraform import, so the generated configuration matches what the provider would manage.
+type Exporter struct {
+	configuration  dto.AtlassianOpsProviderModel
+	organizationId string
+	resources      []exportedResource
+	addresses      map[string]bool
+	// Warnings lists the resources that could not be listed or read and are missing from the export.
+	Warnings []string
+}
+
+type exportedResource struct {
+	typeName string
+	name     string
+	importId string
+	schema   schema.Schema
+	state    tftypes.Value
+}
+
+// Team policies are exported by type in this order, so repeated exports render the same configuration
+var exportedPolicyTypes = []struct {
+	policyType  string
+	newResource func() resource.Resource
+}{
+	{"alert", NewAlertPolicyResource},
+	{"notification", NewNotificationPolicyResource},
+}
+
+// The attributes holding the id of another resource, which are rendered as a reference when that resource is exported.
+// Nested id attributes name the recipients and participants of escalation rules, routing rules and rotations.
+var referenceAttributes = map[string]bool{
+	"id":                true,
+	"team_id":           true,
+	"schedule_id":       true,
+	"integration_id":    true,
+	"maintenance_id":    true,
+	"policy_ids":        true,
+	"source_service_id": true,
+	"target_service_id": true,
+}
+
+func (r exportedResource) address() string {
+	return r.typeName + "." + r.name
+}
+
+// NewExporter returns an Exporter for the tenant of the given configuration. Teams are only exported when the id of
+// their organization is known, as the team resource requires it.
+func NewExporter(configuration dto.AtlassianOpsProviderModel, organizationId string) *Exporter {
+	return &Exporter{
+		configuration:  configuration,
+		organizationId: organizationId,
+		addresses:      make(map[string]bool),
+	}
+}
+
+// Export reads all supported resources of the tenant and writes their configuration and import blocks to w.
+func (e *Exporter) Export(ctx context.Context, w io.Writer) error {
+	e.exportTeams(ctx)
+	e.exportSchedules(ctx)
+	e.exportIntegrations(ctx)
+	e.exportGlobalAlertPolicies(ctx)
+	e.exportGlobalMaintenances(ctx)
+	e.exportServices(ctx)
+	e.exportCustomRoles(ctx)
+
+	_, err := w.Write(e.render().Bytes())
+	return err
+}
+
+func (e *Exporter) warn(format string, args ...any) {
+	e.Warnings = append(e.Warnings, fmt.Sprintf(format, args...))
+}
+
+func (e *Exporter) exportTeams(ctx context.Context) {
+	teams, err := listAll[dto.OpsTeamDto](ctx, e.configuration, "/v1/teams", nil, "team")
+	if err != nil {
+		e.warn("%s", err)
+		return
+	}
+	if e.organizationId == "" && len(teams) > 0 {
+		e.warn("teams are not exported without an organization id, resources of teams refer to them by id")
+	}
+
+	for _, team := range teams {
+		if e.organizationId != "" {
+			e.add(ctx, NewTeamResource, fmt.Sprintf("%s,%s", team.TeamId, e.organizationId), team.TeamName)
+		}
+		e.exportTeamResources(ctx, team)
+	}
+}
+
+func (e *Exporter) exportTeamResources(ctx context.Context, team dto.OpsTeamDto) {
+	teamScopedImportId := func(id string) string {
+		return fmt.Sprintf("%s,%s", id, team.TeamId)
+	}
+
+	escalations, err := listAll[dto.EscalationDto](ctx, e.configuration, fmt.Sprintf("/v1/teams/%s/escalations", team.TeamId), nil, "escalation")
+	e.warnOnError(err)
+	for _, escalation := range escalations {
+		e.add(ctx, NewEscalationResource, teamScopedImportId(escalation.Id), team.TeamName, escalation.Name)
+	}
+
+	routingRules, err := listAll[dto.RoutingRuleDto](ctx, e.configuration, fmt.Sprintf("/v1/teams/%s/routing-rules", team.TeamId), nil, "routing rule")
+	e.warnOnError(err)
+	for _, rule := range routingRules {
+		// The default routing rule exists for every team and can not be managed by the routing rule resource
+		if rule.IsDefault {
+			continue
+		}
+		e.add(ctx, NewRoutingRuleResource, teamScopedImportId(rule.ID), team.TeamName, rule.Name)
+	}
+
+	for _, exported := range exportedPolicyTypes {
+		policies, err := listOrderedPolicies(ctx, e.configuration, team.TeamId, exported.policyType)
+		if err != nil {
+			e.warn("%s", err)
+			continue
+		}
+		for _, policy := range policies {
+			e.add(ctx, exported.newResource, teamScopedImportId(policy.ID), team.TeamName, policy.Name)
+		}
+	}
+
+	heartbeats, err := listAll[dto.HeartbeatDto](ctx, e.configuration, fmt.Sprintf("/v1/teams/%s/heartbeats", team.TeamId), nil, "heartbeat")
+	e.warnOnError(err)
+	for _, heartbeat := range heartbeats {
+		e.add(ctx, NewHeartbeatResource, fmt.Sprintf("%s,%s", heartbeat.Name, team.TeamId), team.TeamName, heartbeat.Name)
+	}
+
+	maintenances, err := listAll[dto.MaintenanceDto](ctx, e.configuration, fmt.Sprintf("/v1/teams/%s/maintenances", team.TeamId), nil, "maintenance")
+	e.warnOnError(err)
+	for _, maintenance := range maintenances {
+		e.add(ctx, NewMaintenanceResource, teamScopedImportId(maintenance.ID), team.TeamName, maintenance.Description)
+	}
+
+	roles, err := listAll[dto.TeamRoleDto](ctx, e.configuration, fmt.Sprintf("/v1/teams/%s/roles", team.TeamId), nil, "team role")
+	e.warnOnError(err)
+	for _, role := range roles {
+		e.add(ctx, NewTeamRoleResource, teamScopedImportId(role.ID), team.TeamName, role.Name)
+	}
+}
+
+func (e *Exporter) exportSchedules(ctx context.Context) {
+	schedules, err := listAll[dto.Schedule](ctx, e.configuration, "/v1/schedules", nil, "schedule")
+	e.warnOnError(err)
+	for _, schedule := range schedules {
+		e.add(ctx, NewScheduleResource, schedule.Id, schedule.Name)
+
+		rotations, err := listAll[dto.Rotation](ctx, e.configuration, fmt.Sprintf("/v1/schedules/%s/rotations", schedule.Id), nil, "rotation")
+		e.warnOnError(err)
+		for _, rotation := range rotations {
+			e.add(ctx, NewScheduleRotationResource, fmt.Sprintf("%s,%s", rotation.Id, schedule.Id), schedule.Name, rotation.Name)
+		}
+	}
+}
+
+func (e *Exporter) exportIntegrations(ctx context.Context) {
+	integrations, err := listAll[dto.ApiIntegration](ctx, e.configuration, "/v1/integrations", nil, "integration")
+	e.warnOnError(err)
+	for _, integration := range integrations {
+		if integration.Type == "Email" {
+			e.add(ctx, NewEmailIntegrationResource, integration.Id, integration.Name)
+		} else {
+			e.add(ctx, NewApiIntegrationResource, integration.Id, integration.Name)
+		}
+
+		actions, err := listAll[dto.BaseIntegrationActionDto](ctx, e.configuration, fmt.Sprintf("/v1/integrations/%s/actions", integration.Id), nil, "integration action")
+		e.warnOnError(err)
+		for _, action := range actions {
+			e.add(ctx, NewIntegrationActionResource, fmt.Sprintf("%s,%s", action.ID, integration.Id), integration.Name, action.Name)
+		}
+	}
+}
+
+func (e *Exporter) exportGlobalAlertPolicies(ctx context.Context) {
+	policies, err := listOrderedPolicies(ctx, e.configuration, "", "alert")
+	e.warnOnError(err)
+	for _, policy := range policies {
+		e.add(ctx, NewAlertPolicyResource, policy.ID, policy.Name)
+	}
+}
+
+func (e *Exporter) exportGlobalMaintenances(ctx context.Context) {
+	maintenances, err := listAll[dto.MaintenanceDto](ctx, e.configuration, "/v1/maintenances", nil, "maintenance")
+	e.warnOnError(err)
+	for _, maintenance := range maintenances {
+		e.add(ctx, NewMaintenanceResource, maintenance.ID, maintenance.Description)
+	}
+}
+
+func (e *Exporter) exportServices(ctx context.Context) {
+	services, err := listServices(ctx, e.configuration)
+	e.warnOnError(err)
+	for _, service := range services {
+		e.add(ctx, NewServiceResource, service.ID, service.Name)
+	}
+}
+
+func (e *Exporter) exportCustomRoles(ctx context.Context) {
+	roles, err := listAll[dto.CustomRoleDto](ctx, e.configuration, "/v1/roles", nil, "custom role")
+	e.warnOnError(err)
+	for _, role := range roles {
+		e.add(ctx, NewCustomRoleResource, role.ID, role.Name)
+	}
+}
+
+func (e *Exporter) warnOnError(err error) {
+	if err != nil {
+		e.warn("%s", err)
+	}
+}
+
+// add reads the resource with the given import identifier the same way terraform import does, and adds it to the
+// export under a name derived from nameParts.
+func (e *Exporter) add(ctx context.Context, newResource func() resource.Resource, importId string, nameParts ...string) {
+	res := newResource()
+
+	metadataResp := resource.MetadataResponse{}
+	res.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "atlassian-operations"}, &metadataResp)
+	schemaResp := resource.SchemaResponse{}
+	res.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if configurable, ok := res.(resource.ResourceWithConfigure); ok {
+		configurable.Configure(ctx, resource.ConfigureRequest{ProviderData: e.configuration}, &resource.ConfigureResponse{})
+	}
+
+	importResp := resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	res.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: importId}, &importResp)
+	if importResp.Diagnostics.HasError() {
+		e.warn("unable to import %s %q: %s", metadataResp.TypeName, importId, diagnosticsSummary(importResp.Diagnostics))
+		return
+	}
+
+	readResp := resource.ReadResponse{State: importResp.State}
+	res.Read(ctx, resource.ReadRequest{State: importResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		e.warn("unable to read %s %q: %s", metadataResp.TypeName, importId, diagnosticsSummary(readResp.Diagnostics))
+		return
+	}
+	if readResp.State.Raw.IsNull() {
+		e.warn("unable to read %s %q: not found", metadataResp.TypeName, importId)
+		return
+	}
+
+	exported := exportedResource{
+		typeName: metadataResp.TypeName,
+		name:     exportResourceName(nameParts...),
+		importId: importId,
+		schema:   schemaResp.Schema,
+		state:    readResp.State.Raw,
+	}
+	for i := 2; e.addresses[exported.address()]; i++ {
+		exported.name = fmt.Sprintf("%s_%d", exportResourceName(nameParts...), i)
+	}
+	e.addresses[exported.address()] = true
+	e.resources = append(e.resources, exported)
+}
+
+func diagnosticsSummary(diags diag.Diagnostics) string {
+	summaries := make([]string, 0)
+	for _, d := range diags.Errors() {
+		summaries = append(summaries, fmt.Sprintf("%s: %s", d.Summary(), d.Detail()))
+	}
+	return strings.Join(summaries, "; ")
+}
+
+var exportResourceNameInvalidCharacters = regexp.MustCompile(`[^a-z0-9]+`)
+
+// exportResourceName derives a valid resource name such as payments_primary from the names of a resource and its
+// parents.
+func exportResourceName(nameParts ...string) string {
+	name := exportResourceNameInvalidCharacters.ReplaceAllString(strings.ToLower(strings.Join(nameParts, "_")), "_")
+	name = strings.Trim(name, "_")
+	if name == "" {
+		return "resource"
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		return "r_" + name
+	}
+	return name
+}
+
+// render writes a resource block per exported resource, followed by the import blocks. Ids of other exported
+// resources are replaced by references to them, so the configuration keeps their relationships.
+func (e *Exporter) render() *hclwrite.File {
+	references := make(map[string]hcl.Traversal)
+	for _, exported := range e.resources {
+		var id string
+		if idValue, ok := exportedAttributeValue(exported.state, "id"); ok && idValue.As(&id) == nil && id != "" {
+			references[id] = hcl.Traversal{
+				hcl.TraverseRoot{Name: exported.typeName},
+				hcl.TraverseAttr{Name: exported.name},
+				hcl.TraverseAttr{Name: "id"},
+			}
+		}
+	}
+
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+	for _, exported := range e.resources {
+		renderResource(body.AppendNewBlock("resource", []string{exported.typeName, exported.name}).Body(), exported, references)
+		body.AppendNewline()
+	}
+
+	for _, exported := range e.resources {
+		block := body.AppendNewBlock("import", nil)
+		block.Body().SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: exported.typeName},
+			hcl.TraverseAttr{Name: exported.name},
+		})
+		block.Body().SetAttributeValue("id", cty.StringVal(exported.importId))
+		body.AppendNewline()
+	}
+
+	return file
+}
+
+func renderResource(body *hclwrite.Body, exported exportedResource, references map[string]hcl.Traversal) {
+	attributes := make(map[string]tftypes.Value)
+	_ = exported.state.As(&attributes)
+
+	// A resource must not refer to itself
+	var id string
+	if idValue, ok := exportedAttributeValue(exported.state, "id"); ok && idValue.As(&id) == nil {
+		if traversal, ok := references[id]; ok {
+			delete(references, id)
+			defer func() { references[id] = traversal }()
+		}
+	}
+
+	for _, name := range sortedKeys(exported.schema.Attributes) {
+		if name == "id" {
+			continue
+		}
+		if tokens, ok := exportedTokens(name, attributes[name], exported.schema.Attributes[name], references); ok {
+			body.SetAttributeRaw(name, tokens)
+		}
+	}
+}
+
+func exportedAttributeValue(state tftypes.Value, name string) (tftypes.Value, bool) {
+	attributes := make(map[string]tftypes.Value)
+	if err := state.As(&attributes); err != nil {
+		return tftypes.Value{}, false
+	}
+	value, ok := attributes[name]
+	return value, ok && value.IsKnown() && !value.IsNull()
+}
+
+// exportedTokens renders the value of an attribute, or returns false if the attribute is not configurable or not set.
+func exportedTokens(name string, value tftypes.Value, attribute schema.Attribute, references map[string]hcl.Traversal) (hclwrite.Tokens, bool) {
+	if attribute == nil || (attribute.IsComputed() && !attribute.IsOptional() && !attribute.IsRequired()) {
+		return nil, false
+	}
+	if value.IsNull() || !value.IsKnown() {
+		return nil, false
+	}
+	return valueTokens(value, nestedAttributes(attribute), references, referenceAttributes[name]), true
+}
+
+func nestedAttributes(attribute schema.Attribute) map[string]schema.Attribute {
+	switch nested := attribute.(type) {
+	case schema.SingleNestedAttribute:
+		return nested.Attributes
+	case schema.ListNestedAttribute:
+		return nested.NestedObject.Attributes
+	case schema.SetNestedAttribute:
+		return nested.NestedObject.Attributes
+	case schema.MapNestedAttribute:
+		return nested.NestedObject.Attributes
+	}
+	return nil
+}
+
+// valueTokens renders a value. The strings of a reference attribute that are the id of an exported resource are
+// rendered as a reference to that resource.
+func valueTokens(value tftypes.Value, nested map[string]schema.Attribute, references map[string]hcl.Traversal, reference bool) hclwrite.Tokens {
+	switch {
+	case value.Type().Is(tftypes.String):
+		var s string
+		_ = value.As(&s)
+		if traversal, ok := references[s]; ok && reference {
+			return hclwrite.TokensForTraversal(traversal)
+		}
+		return hclwrite.TokensForValue(cty.StringVal(s))
+	case value.Type().Is(tftypes.Number):
+		n := new(big.Float)
+		_ = value.As(&n)
+		return hclwrite.TokensForValue(cty.NumberVal(n))
+	case value.Type().Is(tftypes.Bool):
+		var b bool
+		_ = value.As(&b)
+		return hclwrite.TokensForValue(cty.BoolVal(b))
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		_ = value.As(&elements)
+		elementTokens := make([]hclwrite.Tokens, 0, len(elements))
+		for _, element := range elements {
+			elementTokens = append(elementTokens, valueTokens(element, nested, references, reference))
+		}
+		return hclwrite.TokensForTuple(elementTokens)
+	case value.Type().Is(tftypes.Map{}):
+		var elements map[string]tftypes.Value
+		_ = value.As(&elements)
+		attributeTokens := make([]hclwrite.ObjectAttrTokens, 0, len(elements))
+		for _, key := range sortedKeys(elements) {
+			attributeTokens = append(attributeTokens, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForValue(cty.StringVal(key)),
+				Value: valueTokens(elements[key], nested, references, reference),
+			})
+		}
+		return hclwrite.TokensForObject(attributeTokens)
+	default:
+		var attributes map[string]tftypes.Value
+		_ = value.As(&attributes)
+		attributeTokens := make([]hclwrite.ObjectAttrTokens, 0, len(attributes))
+		for _, name := range sortedKeys(attributes) {
+			if nested != nil {
+				tokens, ok := exportedTokens(name, attributes[name], nested[name], references)
+				if !ok {
+					continue
+				}
+				attributeTokens = append(attributeTokens, hclwrite.ObjectAttrTokens{Name: hclwrite.TokensForIdentifier(name), Value: tokens})
+			} else if attributes[name].IsKnown() && !attributes[name].IsNull() {
+				attributeTokens = append(attributeTokens, hclwrite.ObjectAttrTokens{Name: hclwrite.TokensForIdentifier(name), Value: valueTokens(attributes[name], nil, references, referenceAttributes[name])})
+			}
+		}
+		return hclwrite.TokensForObject(attributeTokens)
+	}
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
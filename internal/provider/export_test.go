@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestExportResourceName(t *testing.T) {
+	testCases := map[string][]string{
+		"payments":                 {"Payments"},
+		"payments_primary_on_call": {"Payments", "Primary on-call"},
+		"r_24_7":                   {"24/7"},
+		"resource":                 {"!!"},
+	}
+
+	for want, nameParts := range testCases {
+		if got := exportResourceName(nameParts...); got != want {
+			t.Errorf("exportResourceName(%q) = %q; want %q", nameParts, got, want)
+		}
+	}
+}
+
+func TestExporterRender(t *testing.T) {
+	ctx := context.Background()
+
+	teamSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":           schema.StringAttribute{Computed: true},
+			"display_name": schema.StringAttribute{Required: true},
+			"member_count": schema.Int64Attribute{Computed: true},
+		},
+	}
+	escalationSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":          schema.StringAttribute{Computed: true},
+			"name":        schema.StringAttribute{Required: true},
+			"description": schema.StringAttribute{Optional: true},
+			"team_id":     schema.StringAttribute{Required: true},
+			"enabled":     schema.BoolAttribute{Optional: true},
+			"rules": schema.ListNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"delay":     schema.Int64Attribute{Required: true},
+						"condition": schema.StringAttribute{Optional: true},
+					},
+				},
+			},
+		},
+	}
+
+	ruleType := escalationSchema.Type().TerraformType(ctx).(tftypes.Object).AttributeTypes["rules"].(tftypes.List).ElementType
+	exporter := NewExporter(dto.AtlassianOpsProviderModel{}, "")
+	exporter.resources = []exportedResource{
+		{
+			typeName: "atlassian-operations_team",
+			name:     "payments",
+			importId: "team-id,org-id",
+			schema:   teamSchema,
+			state: tftypes.NewValue(teamSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"id":           tftypes.NewValue(tftypes.String, "team-id"),
+				"display_name": tftypes.NewValue(tftypes.String, "Payments"),
+				"member_count": tftypes.NewValue(tftypes.Number, 3),
+			}),
+		},
+		{
+			typeName: "atlassian-operations_escalation",
+			name:     "payments_primary",
+			importId: "escalation-id,team-id",
+			schema:   escalationSchema,
+			state: tftypes.NewValue(escalationSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"id":          tftypes.NewValue(tftypes.String, "escalation-id"),
+				"name":        tftypes.NewValue(tftypes.String, "Primary"),
+				"description": tftypes.NewValue(tftypes.String, "team-id"),
+				"team_id":     tftypes.NewValue(tftypes.String, "team-id"),
+				"enabled":     tftypes.NewValue(tftypes.Bool, nil),
+				"rules": tftypes.NewValue(tftypes.List{ElementType: ruleType}, []tftypes.Value{
+					tftypes.NewValue(ruleType, map[string]tftypes.Value{
+						"delay":     tftypes.NewValue(tftypes.Number, 5),
+						"condition": tftypes.NewValue(tftypes.String, "if-not-acked"),
+					}),
+				}),
+			}),
+		},
+	}
+
+	got := string(exporter.render().Bytes())
+	for _, want := range []string{
+		`resource "atlassian-operations_team" "payments" {`,
+		`display_name = "Payments"`,
+		`team_id = atlassian-operations_team.payments.id`,
+		`description = "team-id"`,
+		`condition = "if-not-acked"`,
+		`delay     = 5`,
+		`to = atlassian-operations_escalation.payments_primary`,
+		`id = "escalation-id,team-id"`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("rendered configuration does not contain %q:\n%s", want, got)
+		}
+	}
+	for _, unwanted := range []string{"member_count", "enabled", `id = "team-id"`} {
+		if strings.Contains(got, unwanted) {
+			t.Errorf("rendered configuration contains %q:\n%s", unwanted, got)
+		}
+	}
+}
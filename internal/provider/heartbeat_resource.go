@@ -12,14 +12,17 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource                = &HeartbeatResource{}
-	_ resource.ResourceWithConfigure   = &HeartbeatResource{}
-	_ resource.ResourceWithImportState = &HeartbeatResource{}
+	_ resource.Resource                 = &HeartbeatResource{}
+	_ resource.ResourceWithConfigure    = &HeartbeatResource{}
+	_ resource.ResourceWithImportState  = &HeartbeatResource{}
+	_ resource.ResourceWithIdentity     = &HeartbeatResource{}
+	_ resource.ResourceWithUpgradeState = &HeartbeatResource{}
 )
 
 type HeartbeatResource struct {
@@ -34,13 +37,27 @@ func (r *HeartbeatResource) Metadata(_ context.Context, req resource.MetadataReq
 	resp.TypeName = req.ProviderTypeName + "_heartbeat"
 }
 
-func (r *HeartbeatResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *HeartbeatResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     0,
 		Description: "Manage heartbeats in Atlassian Operations.",
 		Attributes:  schemaAttributes.HeartbeatResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
+func (r *HeartbeatResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.HeartbeatResourceIdentityAttributes,
+	}
+}
+
+func (r *HeartbeatResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r)
+}
+
 func (r *HeartbeatResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring HeartbeatResource")
 
@@ -70,6 +87,9 @@ func (r *HeartbeatResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Convert to DTO
 	heartbeatDto, diags := HeartbeatModelToDto(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -79,7 +99,7 @@ func (r *HeartbeatResource) Create(ctx context.Context, req resource.CreateReque
 
 	// Create heartbeat
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/heartbeats", data.TeamID.ValueString())).
 		Method(httpClient.POST).
 		SetBody(heartbeatDto).
@@ -114,22 +134,31 @@ func (r *HeartbeatResource) Create(ctx context.Context, req resource.CreateReque
 	// Update state with response
 	result, diags := HeartbeatDtoToModel(ctx, heartbeatDto, data.TeamID.ValueString())
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	result.Timeouts = data.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *HeartbeatResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data dataModels.HeartbeatModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Trace(ctx, "Reading HeartbeatResource")
 
 	// Get heartbeats and find the one with the specified name
 	var heartbeatPaginatedResponseDto dto.HeartbeatPaginatedResponseDto
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/heartbeats", data.TeamID.ValueString())).
 		Method(httpClient.GET).
 		SetQueryParam("name", data.Name.ValueString()).
@@ -181,6 +210,10 @@ func (r *HeartbeatResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	result, diags := HeartbeatDtoToModel(ctx, heartbeatDto, data.TeamID.ValueString())
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	result.Timeouts = data.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
 }
 
@@ -191,6 +224,9 @@ func (r *HeartbeatResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Convert to DTO
 	heartbeatDto, diags := HeartbeatModelToDto(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -200,7 +236,7 @@ func (r *HeartbeatResource) Update(ctx context.Context, req resource.UpdateReque
 
 	// Update heartbeat
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/heartbeats", data.TeamID.ValueString())).
 		Method(httpClient.PATCH).
 		SetQueryParam("name", data.Name.ValueString()).
@@ -235,7 +271,12 @@ func (r *HeartbeatResource) Update(ctx context.Context, req resource.UpdateReque
 
 	result, diags := HeartbeatDtoToModel(ctx, heartbeatDto, data.TeamID.ValueString())
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	result.Timeouts = data.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *HeartbeatResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -245,8 +286,11 @@ func (r *HeartbeatResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/heartbeats", data.TeamID.ValueString())).
 		Method(httpClient.DELETE).
 		SetQueryParam("name", data.Name.ValueString()).
@@ -279,11 +323,27 @@ func (r *HeartbeatResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *HeartbeatResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		return
+	}
+
+	if names, ok := parseImportReference(req.ID, "team", "heartbeat"); ok {
+		teamId, err := resolveTeamId(ctx, r.clientConfiguration, names[0])
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Resolve Import Identifier", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), names[1])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamId)...)
+		return
+	}
+
 	idParts := strings.Split(req.ID, ",")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: name,team_id. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: name,team_id or team:<team name>/heartbeat:<name>. Got: %q", req.ID),
 		)
 		return
 	}
@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// parseImportReference parses name-based import identifiers such as team:Payments/escalation:Primary, which name a
+// resource and its parents instead of using their ids. It returns the names in the order of the given kinds, and
+// false if the identifier is not a reference of exactly these kinds.
+func parseImportReference(id string, kinds ...string) ([]string, bool) {
+	segments := strings.Split(id, "/")
+	if len(segments) != len(kinds) {
+		return nil, false
+	}
+
+	names := make([]string, len(kinds))
+	for i, segment := range segments {
+		kind, name, found := strings.Cut(segment, ":")
+		if !found || kind != kinds[i] || name == "" {
+			return nil, false
+		}
+		names[i] = name
+	}
+	return names, true
+}
+
+// listRequest creates the requests of a list endpoint, e.g. httpClientHelpers.GenerateJsmOpsClientRequest.
+type listRequest func(ctx context.Context, configuration dto.AtlassianOpsProviderModel) *httpClient.Request
+
+// listError is returned by listEach when the API rejects a page, and keeps its status code for the callers that
+// treat a missing parent as a removed resource.
+type listError struct {
+	statusCode int
+	message    string
+}
+
+func (e *listError) Error() string {
+	return e.message
+}
+
+// listStatusCode returns the status code of a page rejected by the API, or 0 if err is not such an error.
+func listStatusCode(err error) int {
+	var listErr *listError
+	if errors.As(err, &listErr) {
+		return listErr.statusCode
+	}
+	return 0
+}
+
+// listAll pages through a JSM Ops list endpoint and returns all of its elements.
+func listAll[T any](ctx context.Context, configuration dto.AtlassianOpsProviderModel, baseURL string, queryParams map[string]string, label string) ([]T, error) {
+	return listAllWith[T](ctx, configuration, httpClientHelpers.GenerateJsmOpsClientRequest, baseURL, queryParams, label)
+}
+
+// listAllWith pages through a list endpoint of the client created by newRequest and returns all of its elements.
+func listAllWith[T any](ctx context.Context, configuration dto.AtlassianOpsProviderModel, newRequest listRequest, baseURL string, queryParams map[string]string, label string) ([]T, error) {
+	values := make([]T, 0)
+	err := listEachWith(ctx, configuration, newRequest, baseURL, queryParams, label, func(value T) bool {
+		values = append(values, value)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// listEach pages through a JSM Ops list endpoint and calls yield with each of its elements, fetching the next page
+// only once all elements of the current one are consumed. It stops early when yield returns false.
+func listEach[T any](ctx context.Context, configuration dto.AtlassianOpsProviderModel, baseURL string, queryParams map[string]string, label string, yield func(T) bool) error {
+	return listEachWith(ctx, configuration, httpClientHelpers.GenerateJsmOpsClientRequest, baseURL, queryParams, label, yield)
+}
+
+// listEachWith is listEach for a list endpoint of the client created by newRequest.
+func listEachWith[T any](ctx context.Context, configuration dto.AtlassianOpsProviderModel, newRequest listRequest, baseURL string, queryParams map[string]string, label string, yield func(T) bool) error {
+	if queryParams == nil {
+		queryParams = map[string]string{}
+	}
+	plural := pluralLabel(label)
+
+	for {
+		listResponse := dto.ListResponse[T]{}
+		httpResp, err := newRequest(ctx, configuration).
+			JoinBaseUrl(baseURL).
+			Method(httpClient.GET).
+			SetQueryParams(queryParams).
+			SetBodyParseObject(&listResponse).
+			Send()
+
+		if httpResp == nil {
+			return fmt.Errorf("unable to list %s, got nil response", plural)
+		}
+		if httpResp.IsError() {
+			statusCode := httpResp.GetStatusCode()
+			if errorResponse := httpResp.GetErrorBody(); errorResponse != nil {
+				return &listError{statusCode, fmt.Sprintf("unable to list %s, status code: %d. Got response: %s", plural, statusCode, *errorResponse)}
+			}
+			return &listError{statusCode, fmt.Sprintf("unable to list %s, got http response: %d", plural, statusCode)}
+		}
+		if err != nil {
+			return fmt.Errorf("unable to list %s, got error: %s", plural, err)
+		}
+
+		for _, value := range listResponse.Values {
+			if !yield(value) {
+				return nil
+			}
+		}
+
+		if listResponse.Links.Next == "" {
+			return nil
+		}
+
+		parsedURL, err := url.Parse(listResponse.Links.Next)
+		if err != nil {
+			return fmt.Errorf("unable to parse next URL, got error: %s", err)
+		}
+		queryParams = make(map[string]string)
+		for key, queryValues := range parsedURL.Query() {
+			if len(queryValues) > 0 {
+				queryParams[key] = queryValues[0]
+			}
+		}
+		baseURL = parsedURL.Path
+		tflog.Trace(ctx, fmt.Sprintf("Fetching next page of %s", plural))
+	}
+}
+
+// pluralLabel returns the plural of a label such as "team" or "alert policy".
+func pluralLabel(label string) string {
+	if strings.HasSuffix(label, "y") {
+		return strings.TrimSuffix(label, "y") + "ies"
+	}
+	return label + "s"
+}
+
+// findIdByName pages through a JSM Ops list endpoint and returns the id of the only element with the given name.
+func findIdByName[T any](ctx context.Context, configuration dto.AtlassianOpsProviderModel, baseURL string, queryParams map[string]string, label string, name string, nameAndId func(T) (string, string)) (string, error) {
+	values, err := listAll[T](ctx, configuration, baseURL, queryParams, label)
+	if err != nil {
+		return "", err
+	}
+
+	ids := make([]string, 0)
+	for _, value := range values {
+		if valueName, id := nameAndId(value); valueName == name {
+			ids = append(ids, id)
+		}
+	}
+	return uniqueIdByName(ids, label, name)
+}
+
+func uniqueIdByName(ids []string, label string, name string) (string, error) {
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no %s named %q found", label, name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%d %ss are named %q, import it by id instead", len(ids), label, name)
+	}
+}
+
+func resolveTeamId(ctx context.Context, configuration dto.AtlassianOpsProviderModel, name string) (string, error) {
+	return findIdByName(ctx, configuration, "/v1/teams", nil, "team", name, func(team dto.OpsTeamDto) (string, string) {
+		return team.TeamName, team.TeamId
+	})
+}
+
+func resolveScheduleId(ctx context.Context, configuration dto.AtlassianOpsProviderModel, name string) (string, error) {
+	return findIdByName(ctx, configuration, "/v1/schedules", nil, "schedule", name, func(schedule dto.Schedule) (string, string) {
+		return schedule.Name, schedule.Id
+	})
+}
+
+func resolveIntegrationId(ctx context.Context, configuration dto.AtlassianOpsProviderModel, name string) (string, error) {
+	return findIdByName(ctx, configuration, "/v1/integrations", nil, "integration", name, func(integration dto.ApiIntegration) (string, string) {
+		return integration.Name, integration.Id
+	})
+}
+
+func resolveServiceId(ctx context.Context, configuration dto.AtlassianOpsProviderModel, name string) (string, error) {
+	services, err := listServices(ctx, configuration)
+	if err != nil {
+		return "", err
+	}
+
+	ids := make([]string, 0)
+	for _, service := range services {
+		if service.Name == name {
+			ids = append(ids, service.ID)
+		}
+	}
+	return uniqueIdByName(ids, serviceLabel(configuration), name)
+}
+
+// importTeamScopedResource imports resources identified either by id,team_id or by team:<team name>/<kind>:<name>,
+// resolving the names through the list endpoint returned by listUrl.
+func importTeamScopedResource[T any](ctx context.Context, configuration dto.AtlassianOpsProviderModel, req resource.ImportStateRequest, resp *resource.ImportStateResponse, kind string, listUrl func(teamId string) string, queryParams map[string]string, nameAndId func(T) (string, string)) {
+	if names, ok := parseImportReference(req.ID, "team", kind); ok {
+		teamId, err := resolveTeamId(ctx, configuration, names[0])
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Resolve Import Identifier", err.Error())
+			return
+		}
+		id, err := findIdByName(ctx, configuration, listUrl(teamId), queryParams, strings.ReplaceAll(kind, "-", " "), names[1], nameAndId)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Resolve Import Identifier", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamId)...)
+		return
+	}
+
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: id,team_id or team:<team name>/%s:<name>. Got: %q", kind, req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), idParts[1])...)
+}
+
+// importNamedResource imports resources identified either by id or by <kind>:<name>, resolving the name with resolveId.
+func importNamedResource(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, kind string, resolveId func(name string) (string, error)) {
+	if names, ok := parseImportReference(req.ID, kind); ok {
+		id, err := resolveId(names[0])
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Resolve Import Identifier", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestParseImportReference(t *testing.T) {
+	testCases := []struct {
+		id    string
+		kinds []string
+		names []string
+	}{
+		{id: "team:Payments/escalation:Primary", kinds: []string{"team", "escalation"}, names: []string{"Payments", "Primary"}},
+		{id: "schedule:Payments on-call/rotation:Week days", kinds: []string{"schedule", "rotation"}, names: []string{"Payments on-call", "Week days"}},
+		{id: "service:ari:cloud:compass", kinds: []string{"service"}, names: []string{"ari:cloud:compass"}},
+		{id: "team:Payments", kinds: []string{"team"}, names: []string{"Payments"}},
+		{id: "team:Payments/escalation:Primary", kinds: []string{"team", "routing-rule"}},
+		{id: "team:Payments", kinds: []string{"team", "escalation"}},
+		{id: "team:/escalation:Primary", kinds: []string{"team", "escalation"}},
+		{id: "00000000-0000-0000-0000-000000000000,00000000-0000-0000-0000-000000000001", kinds: []string{"team", "escalation"}},
+		{id: "ari:cloud:compass:site/component/id", kinds: []string{"service"}},
+	}
+
+	for _, testCase := range testCases {
+		names, ok := parseImportReference(testCase.id, testCase.kinds...)
+		if ok != (testCase.names != nil) || !slices.Equal(names, testCase.names) {
+			t.Errorf("parseImportReference(%q, %q) = %q, %t; want %q", testCase.id, testCase.kinds, names, ok, testCase.names)
+		}
+	}
+}
+
+func TestListStatusCode(t *testing.T) {
+	testCases := []struct {
+		err        error
+		statusCode int
+	}{
+		{err: &listError{statusCode: 404, message: "unable to list routing rules, got http response: 404"}, statusCode: 404},
+		{err: fmt.Errorf("unable to list teams: %w", &listError{statusCode: 500}), statusCode: 500},
+		{err: errors.New("unable to list teams, got nil response")},
+		{err: nil},
+	}
+
+	for _, testCase := range testCases {
+		if statusCode := listStatusCode(testCase.err); statusCode != testCase.statusCode {
+			t.Errorf("listStatusCode(%v) = %d; want %d", testCase.err, statusCode, testCase.statusCode)
+		}
+	}
+}
+
+func TestPluralLabel(t *testing.T) {
+	for label, plural := range map[string]string{
+		"team":         "teams",
+		"routing rule": "routing rules",
+		"alert policy": "alert policies",
+	} {
+		if got := pluralLabel(label); got != plural {
+			t.Errorf("pluralLabel(%q) = %q; want %q", label, got, plural)
+		}
+	}
+}
@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                 = &IncomingCallRoutingResource{}
+	_ resource.ResourceWithConfigure    = &IncomingCallRoutingResource{}
+	_ resource.ResourceWithImportState  = &IncomingCallRoutingResource{}
+	_ resource.ResourceWithIdentity     = &IncomingCallRoutingResource{}
+	_ resource.ResourceWithUpgradeState = &IncomingCallRoutingResource{}
+)
+
+type IncomingCallRoutingResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func NewIncomingCallRoutingResource() resource.Resource {
+	return &IncomingCallRoutingResource{}
+}
+
+func (r *IncomingCallRoutingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incoming_call_routing"
+}
+
+func (r *IncomingCallRoutingResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     0,
+		Description: "Manage incoming call routings of a team in Atlassian Operations. An incoming call routing allocates a phone number and routes calls made to it to the team's users, schedules or escalation policies.",
+		Attributes:  schemaAttributes.IncomingCallRoutingResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *IncomingCallRoutingResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.IncomingCallRoutingResourceIdentityAttributes,
+	}
+}
+
+func (r *IncomingCallRoutingResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r)
+}
+
+func (r *IncomingCallRoutingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring IncomingCallRoutingResource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+	tflog.Trace(ctx, "Configured IncomingCallRoutingResource")
+}
+
+func (r *IncomingCallRoutingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "Creating IncomingCallRoutingResource")
+
+	var data dataModels.IncomingCallRoutingModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	callRoutingDto, diags := IncomingCallRoutingModelToDto(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/incoming-call-routing", data.TeamID.ValueString())).
+		Method(httpClient.POST).
+		SetBody(callRoutingDto).
+		SetBodyParseObject(callRoutingDto).
+		Send()
+
+	handleHttpResponse(httpResp, err, "create incoming call routing", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = IncomingCallRoutingDtoToModel(data.TeamID.ValueString(), callRoutingDto)
+	data.Timeouts = timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "Created IncomingCallRoutingResource")
+}
+
+func (r *IncomingCallRoutingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "Reading IncomingCallRoutingResource")
+
+	var data dataModels.IncomingCallRoutingModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	var callRoutingDto dto.IncomingCallRoutingDto
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/incoming-call-routing/%s", data.TeamID.ValueString(), data.ID.ValueString())).
+		Method(httpClient.GET).
+		SetBodyParseObject(&callRoutingDto).
+		Send()
+
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+	handleHttpResponse(httpResp, err, "read incoming call routing", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = IncomingCallRoutingDtoToModel(data.TeamID.ValueString(), &callRoutingDto)
+	data.Timeouts = timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Read IncomingCallRoutingResource")
+}
+
+func (r *IncomingCallRoutingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "Updating IncomingCallRoutingResource")
+
+	var data dataModels.IncomingCallRoutingModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	callRoutingDto, diags := IncomingCallRoutingModelToDto(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/incoming-call-routing/%s", data.TeamID.ValueString(), data.ID.ValueString())).
+		Method(httpClient.PATCH).
+		SetBody(callRoutingDto).
+		SetBodyParseObject(callRoutingDto).
+		Send()
+
+	handleHttpResponse(httpResp, err, "update incoming call routing", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = IncomingCallRoutingDtoToModel(data.TeamID.ValueString(), callRoutingDto)
+	data.Timeouts = timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "Updated IncomingCallRoutingResource")
+}
+
+func (r *IncomingCallRoutingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "Deleting IncomingCallRoutingResource")
+
+	var data dataModels.IncomingCallRoutingModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/incoming-call-routing/%s", data.TeamID.ValueString(), data.ID.ValueString())).
+		Method(httpClient.DELETE).
+		Send()
+
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		return
+	}
+	handleHttpResponse(httpResp, err, "delete incoming call routing", &resp.Diagnostics, ctx)
+
+	tflog.Trace(ctx, "Deleted IncomingCallRoutingResource")
+}
+
+func (r *IncomingCallRoutingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		return
+	}
+
+	importTeamScopedResource(ctx, r.clientConfiguration, req, resp, "incoming-call-routing", func(teamId string) string {
+		return fmt.Sprintf("/v1/teams/%s/incoming-call-routing", teamId)
+	}, nil, func(callRouting dto.IncomingCallRoutingDto) (string, string) {
+		return callRouting.Name, callRouting.ID
+	})
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIncomingCallRoutingResource(t *testing.T) {
+	teamName := uuid.NewString()
+	scheduleName := uuid.NewString()
+	callRoutingName := uuid.NewString()
+	callRoutingUpdateName := uuid.NewString()
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	teamConfig := providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_schedule" "example" {
+  name    = "` + scheduleName + `"
+  team_id = atlassian-operations_team.example.id
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: teamConfig + `
+resource "atlassian-operations_incoming_call_routing" "example" {
+  team_id      = atlassian-operations_team.example.id
+  name         = "` + callRoutingName + `"
+  country_code = "US"
+  recipients = [
+    {
+      id   = atlassian-operations_schedule.example.id
+      type = "schedule"
+    }
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("atlassian-operations_incoming_call_routing.example", "id"),
+					resource.TestCheckResourceAttrSet("atlassian-operations_incoming_call_routing.example", "phone_number"),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "name", callRoutingName),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "country_code", "US"),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "enabled", "true"),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "recipients.#", "1"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_incoming_call_routing.example", "recipients.0.id", "atlassian-operations_schedule.example", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "recipients.0.type", "schedule"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "atlassian-operations_incoming_call_routing.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return state.RootModule().Resources["atlassian-operations_incoming_call_routing.example"].Primary.ID +
+							"," +
+							state.RootModule().Resources["atlassian-operations_incoming_call_routing.example"].Primary.Attributes["team_id"],
+						nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: teamConfig + `
+resource "atlassian-operations_incoming_call_routing" "example" {
+  team_id          = atlassian-operations_team.example.id
+  name             = "` + callRoutingUpdateName + `"
+  country_code     = "US"
+  greeting_message = "You have reached the on-call engineer."
+  enabled          = false
+  voice = {
+    language = "en-US"
+    gender   = "female"
+  }
+  recipients = [
+    {
+      id   = data.atlassian-operations_user.test1.account_id
+      type = "user"
+    },
+    {
+      id   = atlassian-operations_schedule.example.id
+      type = "schedule"
+    }
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "name", callRoutingUpdateName),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "greeting_message", "You have reached the on-call engineer."),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "enabled", "false"),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "voice.language", "en-US"),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "voice.gender", "female"),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "recipients.#", "2"),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "recipients.0.type", "user"),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "recipients.1.type", "schedule"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccIncomingCallRoutingResource_InvalidCountryCode(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_incoming_call_routing" "example" {
+  team_id      = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name         = "example"
+  country_code = "usa"
+  recipients = [
+    {
+      id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+      type = "schedule"
+    }
+  ]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("ISO 3166-1 alpha-2"),
+			},
+		},
+	})
+}
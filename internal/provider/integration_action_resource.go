@@ -12,6 +12,7 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -19,6 +20,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &IntegrationActionResource{}
 var _ resource.ResourceWithImportState = &IntegrationActionResource{}
+var _ resource.ResourceWithIdentity = &IntegrationActionResource{}
+var _ resource.ResourceWithUpgradeState = &IntegrationActionResource{}
 
 func NewIntegrationActionResource() resource.Resource {
 	return &IntegrationActionResource{}
@@ -33,12 +36,26 @@ func (r *IntegrationActionResource) Metadata(_ context.Context, req resource.Met
 	resp.TypeName = req.ProviderTypeName + "_integration_action"
 }
 
-func (r *IntegrationActionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IntegrationActionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:    0,
 		Attributes: schemaAttributes.IntegrationActionResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
+func (r *IntegrationActionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.IntegrationActionResourceIdentityAttributes,
+	}
+}
+
+func (r *IntegrationActionResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r)
+}
+
 func (r *IntegrationActionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring IntegrationActionResource")
 
@@ -68,6 +85,9 @@ func (r *IntegrationActionResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Convert to DTO
 	integrationActionDto, diags := IntegrationActionModelToDto(ctx, &data)
 	if diags.HasError() {
@@ -77,7 +97,7 @@ func (r *IntegrationActionResource) Create(ctx context.Context, req resource.Cre
 
 	// Create integration action
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/integrations/%s/actions", data.IntegrationID.ValueString())).
 		Method(httpClient.POST).
 		SetBody(integrationActionDto).
@@ -115,22 +135,28 @@ func (r *IntegrationActionResource) Create(ctx context.Context, req resource.Cre
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	modelPtr.Timeouts = data.Timeouts
 	data = *modelPtr
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *IntegrationActionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data dataModels.IntegrationActionModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Trace(ctx, "Reading IntegrationActionResource")
 
 	var integrationActionDto dto.IntegrationActionDto
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/integrations/%s/actions/%s", data.IntegrationID.ValueString(), data.ID.ValueString())).
 		Method(httpClient.GET).
 		SetBodyParseObject(&integrationActionDto).
@@ -172,6 +198,7 @@ func (r *IntegrationActionResource) Read(ctx context.Context, req resource.ReadR
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	modelPtr.Timeouts = data.Timeouts
 	data = *modelPtr
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -183,6 +210,9 @@ func (r *IntegrationActionResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Convert to DTO
 	integrationActionDto, diags := IntegrationActionModelToDto(ctx, &data)
 	if diags.HasError() {
@@ -192,7 +222,7 @@ func (r *IntegrationActionResource) Update(ctx context.Context, req resource.Upd
 
 	// Update integration action
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/integrations/%s/actions/%s", data.IntegrationID.ValueString(), data.ID.ValueString())).
 		Method(httpClient.PATCH).
 		SetBody(integrationActionDto).
@@ -229,8 +259,10 @@ func (r *IntegrationActionResource) Update(ctx context.Context, req resource.Upd
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	modelPtr.Timeouts = data.Timeouts
 	data = *modelPtr
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *IntegrationActionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -240,9 +272,12 @@ func (r *IntegrationActionResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete integration action
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/integrations/%s/actions/%s", data.IntegrationID.ValueString(), data.ID.ValueString())).
 		Method(httpClient.DELETE).
 		Send()
@@ -274,11 +309,34 @@ func (r *IntegrationActionResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *IntegrationActionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		return
+	}
+
+	if names, ok := parseImportReference(req.ID, "integration", "action"); ok {
+		integrationId, err := resolveIntegrationId(ctx, r.clientConfiguration, names[0])
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Resolve Import Identifier", err.Error())
+			return
+		}
+		id, err := findIdByName(ctx, r.clientConfiguration, fmt.Sprintf("/v1/integrations/%s/actions", integrationId), nil, "integration action", names[1], func(action dto.BaseIntegrationActionDto) (string, string) {
+			return action.Name, action.ID
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Resolve Import Identifier", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("integration_id"), integrationId)...)
+		return
+	}
+
 	idParts := strings.Split(req.ID, ",")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: id,integration_id. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: id,integration_id or integration:<integration name>/action:<name>. Got: %q", req.ID),
 		)
 		return
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/google/uuid"
@@ -282,3 +283,52 @@ resource "atlassian-operations_integration_action" "example" {
 		},
 	})
 }
+
+func TestAccIntegrationActionResource_InvalidFilterConditions(t *testing.T) {
+	config := func(condition string) string {
+		return providerConfig + `
+resource "atlassian-operations_integration_action" "example" {
+  integration_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name           = "Invalid Integration Action"
+  type           = "create"
+  domain         = "alert"
+  direction      = "incoming"
+
+  filter = {
+    conditions_empty     = false
+    condition_match_type = "match-any-condition"
+    conditions = [
+      {
+        ` + condition + `
+      }
+    ]
+  }
+}`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(`field = "message", operation = "is-empty", expected_value = "error"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Condition Expected Value"),
+			},
+			{
+				Config:      config(`field = "tags", operation = "starts-with", expected_value = "critical"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Condition Operation"),
+			},
+			{
+				Config:      config(`field = "extra-properties", operation = "contains-key"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Missing Condition Key"),
+			},
+			{
+				Config:      config(`field = "priority", operation = "greater-than", expected_value = "urgent"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Condition Expected Value"),
+			},
+		},
+	})
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &IntegrationApiKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &IntegrationApiKeyEphemeralResource{}
+)
+
+func NewIntegrationApiKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &IntegrationApiKeyEphemeralResource{}
+}
+
+// IntegrationApiKeyEphemeralResource defines the ephemeral resource implementation.
+type IntegrationApiKeyEphemeralResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (r *IntegrationApiKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_api_key"
+}
+
+func (r *IntegrationApiKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the API key of an integration, e.g. to write it to a secrets manager from another configuration, without storing it in the plan or state. The key is only returned when the API exposes it and is never reset, use `rotate_key_trigger` of the `atlassian-operations_api_integration` resource to rotate it.",
+		Attributes:  schemaAttributes.IntegrationApiKeyEphemeralResourceAttributes,
+	}
+}
+
+func (r *IntegrationApiKeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring IntegrationApiKeyEphemeralResource")
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Unexpected Ephemeral Resource Configure Type")
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+
+	tflog.Trace(ctx, "Configured IntegrationApiKeyEphemeralResource")
+}
+
+func (r *IntegrationApiKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	tflog.Trace(ctx, "Opening the IntegrationApiKeyEphemeralResource")
+
+	var data dataModels.IntegrationApiKeyModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integrationId := data.IntegrationId.ValueString()
+
+	// Terraform opens ephemeral resources during every plan and apply, so the key is only read here and never reset.
+	// Keys are rotated through rotate_key_trigger of the api_integration resource.
+	integration := dto.ApiIntegration{}
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("v1/integrations/%s", integrationId)).
+		Method(httpClient.GET).
+		SetBodyParseObject(&integration).
+		Send()
+
+	if httpResp == nil {
+		tflog.Error(ctx, "Client Error. Unable to read integration api key, got nil response")
+		resp.Diagnostics.AddError("Client Error", "Unable to read integration api key, got nil response")
+	} else if httpResp.IsError() {
+		statusCode := httpResp.GetStatusCode()
+		errorResponse := httpResp.GetErrorBody()
+		if errorResponse != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read integration api key, status code: %d. Got response: %s", statusCode, *errorResponse))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read integration api key, status code: %d. Got response: %s", statusCode, *errorResponse))
+		} else {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read integration api key, got http response: %d", statusCode))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read integration api key, got http response: %d", statusCode))
+		}
+	}
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read integration api key, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read integration api key, got error: %s", err))
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if integration.ApiKey == "" {
+		tflog.Warn(ctx, "The API did not return an api key for the integration")
+		resp.Diagnostics.AddWarning(
+			"Api Key Not Available",
+			fmt.Sprintf("The API did not return an api key for integration %s, api_key is null. The key is only exposed when the integration is created; change rotate_key_trigger of the api_integration resource to generate a new one.", integrationId),
+		)
+		data.ApiKey = types.StringNull()
+	} else {
+		data.ApiKey = types.StringValue(integration.ApiKey)
+	}
+
+	tflog.Trace(ctx, "Opened the IntegrationApiKeyEphemeralResource")
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
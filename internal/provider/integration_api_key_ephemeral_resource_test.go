@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIntegrationApiKeyEphemeralResource(t *testing.T) {
+	apiIntegrationName := uuid.NewString()
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	apiPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	// The ephemeral resource is opened during every plan, the key stored on creation must stay valid across them
+	sameApiKey := statecheck.CompareValue(compare.ValuesSame())
+
+	config := providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + apiPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  display_name = "` + teamName + `"
+  description = "team description"
+  organization_id = "` + organizationId + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+       account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_api_integration" "example" {
+  name          = "` + apiIntegrationName + `"
+  team_id       = atlassian-operations_team.example.id
+  type          = "API"
+  enabled       = true
+}
+
+ephemeral "atlassian-operations_integration_api_key" "example" {
+  integration_id = atlassian-operations_api_integration.example.id
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0"))),
+		},
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if apiPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Create the integration and fetch its key through the ephemeral resource
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_api_integration.example", "name", apiIntegrationName),
+					resource.TestCheckResourceAttrSet("atlassian-operations_api_integration.example", "api_key"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameApiKey.AddStateValue("atlassian-operations_api_integration.example", tfjsonpath.New("api_key")),
+				},
+			},
+			// Plan twice, which opens the ephemeral resource each time
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+			// Refresh the integration, a reset key would differ from the one returned on creation
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameApiKey.AddStateValue("atlassian-operations_api_integration.example", tfjsonpath.New("api_key")),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/customTypes"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Email integrations are API integrations of this type, both resources manage the same /v1/integrations/{id} object
+const emailIntegrationType = "Email"
+
+// integrationStateMover moves the state of another integration resource of this provider, identified by its
+// resource type name, to the resource the mover is declared on. Integrations keep their ID, so moving between
+// resource types with a moved block neither recreates the integration nor rotates its API key.
+func integrationStateMover(ctx context.Context, source resource.Resource, sourceTypeName string, move func(context.Context, resource.MoveStateRequest, *resource.MoveStateResponse)) resource.StateMover {
+	var schemaResp resource.SchemaResponse
+	source.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	sourceSchema := schemaResp.Schema
+
+	return resource.StateMover{
+		SourceSchema: &sourceSchema,
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			if req.SourceTypeName != sourceTypeName {
+				return
+			}
+			if req.SourceState == nil || req.SourceSchemaVersion != sourceSchema.Version {
+				resp.Diagnostics.AddError(
+					"Unable to Move Resource State",
+					fmt.Sprintf("The state of %s could not be read. Apply the configuration with the current provider version before moving the resource.", sourceTypeName),
+				)
+				return
+			}
+
+			move(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			setIdentityFromState(ctx, resp.TargetState, resp.TargetIdentity, &resp.Diagnostics)
+		},
+	}
+}
+
+func moveEmailIntegrationToApiIntegration(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	var source dataModels.EmailIntegrationModel
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	typeSpecificProperties := customTypes.NewJsonWithDefaultsNull()
+	if !source.TypeSpecificPropertiesModel.IsNull() {
+		var properties dataModels.TypeSpecificPropertiesModel
+		resp.Diagnostics.Append(source.TypeSpecificPropertiesModel.As(ctx, &properties, basetypes.ObjectAsOptions{})...)
+		encoded, err := json.Marshal(EmailIntegrationTypeSpecificPropertiesModelToDto(properties))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Move Resource State", fmt.Sprintf("Unable to encode the type specific properties: %s", err))
+			return
+		}
+		typeSpecificProperties = customTypes.NewJsonWithDefaultsValue(string(encoded))
+	}
+
+	// The API key of an existing integration can not be read, it stays unset until rotate_key_trigger is changed
+	data := dataModels.ApiIntegrationModel{
+		Id:                     source.Id,
+		Name:                   source.Name,
+		ApiKey:                 types.StringNull(),
+		Type:                   types.StringValue(emailIntegrationType),
+		Enabled:                source.Enabled,
+		TeamId:                 source.TeamId,
+		Advanced:               source.Advanced,
+		MaintenanceSources:     source.MaintenanceSources,
+		Directions:             source.Directions,
+		Domains:                source.Domains,
+		TypeSpecificProperties: typeSpecificProperties,
+		RotateKeyTrigger:       types.StringNull(),
+		DeleteDefaultActions:   types.BoolValue(false),
+		DeletionProtection:     types.BoolValue(false),
+		Timeouts:               source.Timeouts,
+	}
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+}
+
+func moveApiIntegrationToEmailIntegration(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	var source dataModels.ApiIntegrationModel
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if source.Type.ValueString() != emailIntegrationType {
+		resp.Diagnostics.AddError(
+			"Unable to Move Resource State",
+			fmt.Sprintf("Only integrations of type %s can be managed as email integrations, got type %q.", emailIntegrationType, source.Type.ValueString()),
+		)
+		return
+	}
+
+	typeSpecificProperties := types.ObjectNull(dataModels.TypeSpecificPropertiesModelMap)
+	if !source.TypeSpecificProperties.IsNull() {
+		var properties dto.TypeSpecificPropertiesDto
+		if err := json.Unmarshal([]byte(source.TypeSpecificProperties.ValueString()), &properties); err != nil {
+			resp.Diagnostics.AddError("Unable to Move Resource State", fmt.Sprintf("Unable to parse the type specific properties: %s", err))
+			return
+		}
+		toModel := EmailIntegrationTypeSpecificPropertiesDtoToModel(properties)
+		typeSpecificProperties = toModel.AsValue()
+	}
+
+	data := dataModels.EmailIntegrationModel{
+		Id:                          source.Id,
+		Name:                        source.Name,
+		Enabled:                     source.Enabled,
+		TeamId:                      source.TeamId,
+		Advanced:                    source.Advanced,
+		Directions:                  source.Directions,
+		Domains:                     source.Domains,
+		MaintenanceSources:          source.MaintenanceSources,
+		TypeSpecificPropertiesModel: typeSpecificProperties,
+		Timeouts:                    source.Timeouts,
+	}
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/customTypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestIntegrationMoveState moves the state fixture of an email integration to an API integration and back, which
+// must keep the integration unchanged.
+func TestIntegrationMoveState(t *testing.T) {
+	ctx := context.Background()
+
+	emailState := currentStateFixture(t, ctx, NewEmailIntegrationResource(), "email_integration")
+
+	apiResp := moveState(t, ctx, NewApiIntegrationResource(), "atlassian-operations_email_integration", emailState)
+	if apiResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", apiResp.Diagnostics)
+	}
+	var integrationType types.String
+	var typeSpecificProperties customTypes.JsonWithDefaults
+	apiResp.TargetState.GetAttribute(ctx, path.Root("type"), &integrationType)
+	apiResp.TargetState.GetAttribute(ctx, path.Root("type_specific_properties"), &typeSpecificProperties)
+	if integrationType.ValueString() != "Email" {
+		t.Errorf("moved integration has type %q, want Email", integrationType.ValueString())
+	}
+	if !strings.Contains(typeSpecificProperties.ValueString(), `"emailUsername":"example-email_username"`) {
+		t.Errorf("moved integration has type_specific_properties %s", typeSpecificProperties.ValueString())
+	}
+
+	emailResp := moveState(t, ctx, NewEmailIntegrationResource(), "atlassian-operations_api_integration", apiResp.TargetState)
+	if emailResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", emailResp.Diagnostics)
+	}
+	if !emailResp.TargetState.Raw.Equal(emailState.Raw) {
+		t.Errorf("email integration changed after moving back:\n%s\nwant:\n%s", emailResp.TargetState.Raw, emailState.Raw)
+	}
+}
+
+func TestIntegrationMoveState_NonEmailIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	apiState := currentStateFixture(t, ctx, NewApiIntegrationResource(), "api_integration")
+	resp := moveState(t, ctx, NewEmailIntegrationResource(), "atlassian-operations_api_integration", apiState)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected moving an integration of another type than Email to fail")
+	}
+}
+
+func TestIntegrationMoveState_OtherResource(t *testing.T) {
+	ctx := context.Background()
+
+	emailState := currentStateFixture(t, ctx, NewEmailIntegrationResource(), "email_integration")
+	resp := moveState(t, ctx, NewApiIntegrationResource(), "atlassian-operations_team", emailState)
+	if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+		t.Fatal("expected the move of another resource type to be skipped")
+	}
+}
+
+func currentStateFixture(t *testing.T, ctx context.Context, res resource.Resource, name string) tfsdk.State {
+	t.Helper()
+
+	var schemaResp resource.SchemaResponse
+	res.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	raw, err := (&tfprotov6.RawState{JSON: readStateUpgradeFixture(t, name, schemaResp.Schema.Version)}).Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatalf("fixture does not match the current schema: %s", err)
+	}
+	return tfsdk.State{Schema: schemaResp.Schema, Raw: raw}
+}
+
+func moveState(t *testing.T, ctx context.Context, target resource.Resource, sourceTypeName string, source tfsdk.State) resource.MoveStateResponse {
+	t.Helper()
+
+	var schemaResp resource.SchemaResponse
+	target.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identitySchemaResp resource.IdentitySchemaResponse
+	target.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+	resp := resource.MoveStateResponse{
+		TargetState: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+		TargetIdentity: &tfsdk.ResourceIdentity{
+			Schema: identitySchemaResp.IdentitySchema,
+			Raw:    tftypes.NewValue(identitySchemaResp.IdentitySchema.Type().TerraformType(ctx), nil),
+		},
+	}
+	req := resource.MoveStateRequest{
+		SourceProviderAddress: "registry.terraform.io/atlassian/atlassian-operations",
+		SourceTypeName:        sourceTypeName,
+		SourceSchemaVersion:   source.Schema.GetVersion(),
+		SourceState:           &source,
+	}
+
+	for _, mover := range target.(resource.ResourceWithMoveState).MoveState(ctx) {
+		mover.StateMover(ctx, req, &resp)
+		if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+			break
+		}
+	}
+	return resp
+}
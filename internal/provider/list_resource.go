@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"iter"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// listResults streams the elements of a JSM Ops list endpoint as list results, fetching further pages only as the
+// results are consumed. describe returns the display name and the identity of an element, or false to leave the
+// element out. When the resource data is requested, each element is read through the Read method of its resource.
+func listResults[T any](ctx context.Context, configuration dto.AtlassianOpsProviderModel, req list.ListRequest, newResource func() resource.Resource, baseURL string, queryParams map[string]string, label string, describe func(T) (string, map[string]string, bool)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		var count int64
+
+		err := listEach(ctx, configuration, baseURL, queryParams, label, func(value T) bool {
+			displayName, identity, ok := describe(value)
+			if !ok {
+				return true
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = displayName
+			for name, id := range identity {
+				result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(name), id)...)
+			}
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				readListedResource(ctx, configuration, newResource, &result)
+			}
+
+			count++
+			return push(result) && (req.Limit <= 0 || count < req.Limit)
+		})
+
+		if err != nil {
+			var diags diag.Diagnostics
+			diags.AddError("Client Error", err.Error())
+			push(list.ListResult{Diagnostics: diags})
+		}
+	}
+}
+
+// readListedResource fills the resource data of a list result, reading the resource the same way it is read after
+// an import by identity.
+func readListedResource(ctx context.Context, configuration dto.AtlassianOpsProviderModel, newResource func() resource.Resource, result *list.ListResult) {
+	res := newResource()
+	if configurable, ok := res.(resource.ResourceWithConfigure); ok {
+		configureResp := resource.ConfigureResponse{}
+		configurable.Configure(ctx, resource.ConfigureRequest{ProviderData: configuration}, &configureResp)
+		result.Diagnostics.Append(configureResp.Diagnostics...)
+	}
+
+	state := tfsdk.State{
+		Schema: result.Resource.Schema,
+		Raw:    result.Resource.Raw,
+	}
+	setStateFromIdentity(ctx, result.Identity, &state, &result.Diagnostics)
+	if result.Diagnostics.HasError() {
+		return
+	}
+
+	readResp := resource.ReadResponse{State: state}
+	res.Read(ctx, resource.ReadRequest{State: state}, &readResp)
+	result.Diagnostics.Append(readResp.Diagnostics...)
+	result.Resource.Raw = readResp.State.Raw
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
@@ -12,14 +13,18 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource                = &MaintenanceResource{}
-	_ resource.ResourceWithConfigure   = &MaintenanceResource{}
-	_ resource.ResourceWithImportState = &MaintenanceResource{}
+	_ resource.Resource                   = &MaintenanceResource{}
+	_ resource.ResourceWithConfigure      = &MaintenanceResource{}
+	_ resource.ResourceWithImportState    = &MaintenanceResource{}
+	_ resource.ResourceWithIdentity       = &MaintenanceResource{}
+	_ resource.ResourceWithUpgradeState   = &MaintenanceResource{}
+	_ resource.ResourceWithValidateConfig = &MaintenanceResource{}
 )
 
 // MaintenanceResource defines the resource implementation for maintenances
@@ -37,13 +42,27 @@ func (r *MaintenanceResource) Metadata(_ context.Context, req resource.MetadataR
 }
 
 // Schema defines the schema for the resource
-func (r *MaintenanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *MaintenanceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     0,
 		Description: "Manage maintenance windows in Atlassian Operations.",
 		Attributes:  schemaAttributes.MaintenanceResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
+func (r *MaintenanceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.MaintenanceResourceIdentityAttributes,
+	}
+}
+
+func (r *MaintenanceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r)
+}
+
 // Configure sets up the resource with provider configuration
 func (r *MaintenanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring MaintenanceResource")
@@ -65,6 +84,44 @@ func (r *MaintenanceResource) Configure(ctx context.Context, req resource.Config
 	tflog.Trace(ctx, "Configured MaintenanceResource")
 }
 
+func (r *MaintenanceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data dataModels.MaintenanceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.StartDate.IsUnknown() || data.StartDate.IsNull() || data.EndDate.IsUnknown() || data.EndDate.IsNull() {
+		return
+	}
+	startDate, err := time.Parse(time.RFC3339, data.StartDate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("start_date"),
+			"Invalid Maintenance Period",
+			fmt.Sprintf("start_date must be an ISO8601 date-time, e.g. 2023-06-15T10:00:00Z, got: %s", data.StartDate.ValueString()),
+		)
+	}
+	endDate, err := time.Parse(time.RFC3339, data.EndDate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_date"),
+			"Invalid Maintenance Period",
+			fmt.Sprintf("end_date must be an ISO8601 date-time, e.g. 2023-06-15T14:00:00Z, got: %s", data.EndDate.ValueString()),
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !endDate.After(startDate) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_date"),
+			"Invalid Maintenance Period",
+			"end_date must be after start_date.",
+		)
+	}
+}
+
 // Create handles the create operation for the resource
 func (r *MaintenanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Trace(ctx, "Creating MaintenanceResource")
@@ -75,6 +132,9 @@ func (r *MaintenanceResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Convert to DTO
 	maintenanceDto, diags := MaintenanceModelToDto(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -91,7 +151,7 @@ func (r *MaintenanceResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(endpoint).
 		Method(httpClient.POST).
 		SetBody(maintenanceDto).
@@ -126,17 +186,26 @@ func (r *MaintenanceResource) Create(ctx context.Context, req resource.CreateReq
 	// Update state with response
 	result, diags := MaintenanceDtoToModel(ctx, maintenanceDto)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	result.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Read handles the read operation for the resource
 func (r *MaintenanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state dataModels.MaintenanceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Trace(ctx, "Reading MaintenanceResource")
 
 	// Determine endpoint based on whether we have a team ID
@@ -149,7 +218,7 @@ func (r *MaintenanceResource) Read(ctx context.Context, req resource.ReadRequest
 
 	var maintenanceDto dto.MaintenanceDto
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(endpoint).
 		Method(httpClient.GET).
 		SetBodyParseObject(&maintenanceDto).
@@ -191,6 +260,10 @@ func (r *MaintenanceResource) Read(ctx context.Context, req resource.ReadRequest
 
 	result, diags := MaintenanceDtoToModel(ctx, &maintenanceDto)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	result.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
 }
 
@@ -202,6 +275,9 @@ func (r *MaintenanceResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Convert to DTO
 	maintenanceDto, diags := MaintenanceModelToDto(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -219,7 +295,7 @@ func (r *MaintenanceResource) Update(ctx context.Context, req resource.UpdateReq
 
 	// Update maintenance window
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(endpoint).
 		Method(httpClient.PATCH).
 		SetBody(maintenanceDto).
@@ -253,7 +329,12 @@ func (r *MaintenanceResource) Update(ctx context.Context, req resource.UpdateReq
 
 	result, diags := MaintenanceDtoToModel(ctx, maintenanceDto)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	result.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Delete handles the delete operation for the resource
@@ -264,6 +345,9 @@ func (r *MaintenanceResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Determine endpoint based on whether we have a team ID
 	var endpoint string
 	if state.TeamID.ValueString() != "" {
@@ -273,7 +357,7 @@ func (r *MaintenanceResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(endpoint).
 		Method(httpClient.DELETE).
 		Send()
@@ -306,6 +390,11 @@ func (r *MaintenanceResource) Delete(ctx context.Context, req resource.DeleteReq
 
 // ImportState handles importing the state of an existing resource
 func (r *MaintenanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		return
+	}
+
 	idParts := strings.Split(req.ID, ",")
 	if len(idParts) != 1 && len(idParts) != 2 {
 		resp.Diagnostics.AddError(
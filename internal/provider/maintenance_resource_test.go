@@ -4,6 +4,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -288,3 +289,32 @@ resource "atlassian-operations_maintenance" "team_test" {
 }
 `
 }
+
+func TestAccMaintenanceResource_InvalidPeriod(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_maintenance" "test" {
+  description = "Test Maintenance Window"
+  start_date  = "2029-06-15T14:00:00Z"
+  end_date    = "2029-06-15T10:00:00Z"
+
+  rules = [
+	{
+		state = "disabled"
+		entity = {
+			id   = "00000000-0000-0000-0000-000000000000"
+			type = "integration"
+		}
+	}
+  ]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Maintenance Period"),
+			},
+		},
+	})
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"strings"
 
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
@@ -13,14 +12,19 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource                = &NotificationPolicyResource{}
-	_ resource.ResourceWithConfigure   = &NotificationPolicyResource{}
-	_ resource.ResourceWithImportState = &NotificationPolicyResource{}
+	_ resource.Resource                   = &NotificationPolicyResource{}
+	_ resource.ResourceWithConfigure      = &NotificationPolicyResource{}
+	_ resource.ResourceWithImportState    = &NotificationPolicyResource{}
+	_ resource.ResourceWithIdentity       = &NotificationPolicyResource{}
+	_ resource.ResourceWithUpgradeState   = &NotificationPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &NotificationPolicyResource{}
 )
 
 type NotificationPolicyResource struct {
@@ -35,12 +39,26 @@ func (r *NotificationPolicyResource) Metadata(_ context.Context, req resource.Me
 	resp.TypeName = req.ProviderTypeName + "_notification_policy"
 }
 
-func (r *NotificationPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *NotificationPolicyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:    0,
 		Attributes: schemaAttributes.NotificationPolicyResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
+func (r *NotificationPolicyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.NotificationPolicyResourceIdentityAttributes,
+	}
+}
+
+func (r *NotificationPolicyResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r)
+}
+
 func (r *NotificationPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring NotificationPolicyResource")
 
@@ -61,6 +79,63 @@ func (r *NotificationPolicyResource) Configure(ctx context.Context, req resource
 	tflog.Trace(ctx, "Configured NotificationPolicyResource")
 }
 
+func (r *NotificationPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data dataModels.NotificationPolicyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.TimeRestriction.IsNull() && !data.TimeRestriction.IsUnknown() {
+		var timeRestriction dataModels.NotificationPolicyTimeRestrictionModel
+		resp.Diagnostics.Append(data.TimeRestriction.As(ctx, &timeRestriction, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !timeRestriction.TimeRestrictions.IsUnknown() {
+			var periods []dataModels.NotificationPolicyTimeRestrictionSettingsModel
+			resp.Diagnostics.Append(timeRestriction.TimeRestrictions.ElementsAs(ctx, &periods, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			if timeRestriction.Enabled.ValueBool() && len(periods) == 0 {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("time_restriction").AtName("time_restrictions"),
+					"Invalid Time Restriction",
+					"At least one time restriction period must be set when time_restriction is enabled.",
+				)
+			}
+			for i, period := range periods {
+				validatePolicyTimeRestrictionPeriod(period.StartHour, period.StartMinute, period.EndHour, period.EndMinute,
+					path.Root("time_restriction").AtName("time_restrictions").AtListIndex(i), &resp.Diagnostics)
+			}
+		}
+	}
+
+	if !data.AutoRestartAction.IsNull() && !data.AutoRestartAction.IsUnknown() {
+		var action dataModels.AutoRestartActionModel
+		resp.Diagnostics.Append(data.AutoRestartAction.As(ctx, &action, basetypes.ObjectAsOptions{})...)
+		validateDurationFormat(action.WaitDuration, action.DurationFormat, path.Root("auto_restart_action"), &resp.Diagnostics)
+	}
+	if !data.AutoCloseAction.IsNull() && !data.AutoCloseAction.IsUnknown() {
+		var action dataModels.AutoCloseActionModel
+		resp.Diagnostics.Append(data.AutoCloseAction.As(ctx, &action, basetypes.ObjectAsOptions{})...)
+		validateDurationFormat(action.WaitDuration, action.DurationFormat, path.Root("auto_close_action"), &resp.Diagnostics)
+	}
+	if !data.DeduplicationAction.IsNull() && !data.DeduplicationAction.IsUnknown() {
+		var action dataModels.DeduplicationActionModel
+		resp.Diagnostics.Append(data.DeduplicationAction.As(ctx, &action, basetypes.ObjectAsOptions{})...)
+		validateDurationFormat(action.WaitDuration, action.DurationFormat, path.Root("deduplication_action"), &resp.Diagnostics)
+	}
+	if !data.DelayAction.IsNull() && !data.DelayAction.IsUnknown() {
+		var action dataModels.DelayActionModel
+		resp.Diagnostics.Append(data.DelayAction.As(ctx, &action, basetypes.ObjectAsOptions{})...)
+		validateDurationFormat(action.WaitDuration, action.DurationFormat, path.Root("delay_action"), &resp.Diagnostics)
+	}
+}
+
 func (r *NotificationPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Trace(ctx, "Creating NotificationPolicyResource")
 
@@ -70,12 +145,15 @@ func (r *NotificationPolicyResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Convert to DTO
 	notificationPolicyDto, _ := NotificationPolicyModelToDto(ctx, &data)
 
 	// Create notification policy
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/policies", data.TeamID.ValueString())).
 		Method(httpClient.POST).
 		SetBody(notificationPolicyDto).
@@ -112,21 +190,27 @@ func (r *NotificationPolicyResource) Create(ctx context.Context, req resource.Cr
 
 	// Update state with response
 	result, _ := NotificationPolicyDtoToModel(ctx, order, notificationPolicyDto)
+	result.Timeouts = data.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *NotificationPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data dataModels.NotificationPolicyModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Trace(ctx, "Reading NotificationPolicyResource")
 
 	var notificationPolicyDto dto.NotificationPolicyDto
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/policies/%s", data.TeamID.ValueString(), data.ID.ValueString())).
 		Method(httpClient.GET).
 		SetBodyParseObject(&notificationPolicyDto).
@@ -166,6 +250,7 @@ func (r *NotificationPolicyResource) Read(ctx context.Context, req resource.Read
 	order := getNotificationPolicyOrder(ctx, r.clientConfiguration, data.TeamID.ValueString(), notificationPolicyDto.ID)
 
 	result, _ := NotificationPolicyDtoToModel(ctx, order, &notificationPolicyDto)
+	result.Timeouts = data.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
 }
 
@@ -176,12 +261,15 @@ func (r *NotificationPolicyResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Convert to DTO
 	notificationPolicyDto, _ := NotificationPolicyModelToDto(ctx, &data)
 
 	// Update notification policy
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/policies/%s", data.TeamID.ValueString(), data.ID.ValueString())).
 		Method(httpClient.PUT).
 		SetBody(notificationPolicyDto).
@@ -215,7 +303,9 @@ func (r *NotificationPolicyResource) Update(ctx context.Context, req resource.Up
 
 	order := getNotificationPolicyOrder(ctx, r.clientConfiguration, data.TeamID.ValueString(), notificationPolicyDto.ID)
 	result, _ := NotificationPolicyDtoToModel(ctx, order, notificationPolicyDto)
+	result.Timeouts = data.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *NotificationPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -225,8 +315,11 @@ func (r *NotificationPolicyResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/policies/%s", data.TeamID.ValueString(), data.ID.ValueString())).
 		Method(httpClient.DELETE).
 		Send()
@@ -258,16 +351,14 @@ func (r *NotificationPolicyResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *NotificationPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: id,team_id. Got: %q", req.ID),
-		)
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
 		return
 	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), idParts[1])...)
+
+	importTeamScopedResource(ctx, r.clientConfiguration, req, resp, "policy", policiesBaseUrl, map[string]string{"type": "notification"}, func(policy dto.BaseAlertPolicyDto) (string, string) {
+		return policy.Name, policy.ID
+	})
 }
 
 func getNotificationPolicyOrder(ctx context.Context, configuration dto.AtlassianOpsProviderModel, teamId string, notificationPolicyId string) float64 {
@@ -282,7 +373,7 @@ func getNotificationPolicyOrder(ctx context.Context, configuration dto.Atlassian
 
 	for !doneLooping {
 		req := httpClientHelpers.
-			GenerateJsmOpsClientRequest(configuration).
+			GenerateJsmOpsClientRequest(ctx, configuration).
 			JoinBaseUrl(baseURL).
 			Method(httpClient.GET).
 			SetBodyParseObject(&listNotificationPoliciesDto).
@@ -3,6 +3,7 @@ package provider
 import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/google/uuid"
@@ -264,3 +265,27 @@ resource "atlassian-operations_notification_policy" "test" {
 		},
 	})
 }
+
+func TestAccNotificationPolicyResource_MissingDurationFormat(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_notification_policy" "test" {
+  name    = "notification policy"
+  type    = "notification"
+  team_id = "00000000-0000-0000-0000-000000000000"
+  enabled = true
+
+  auto_close_action = {
+    wait_duration = 30
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Missing Duration Format"),
+			},
+		},
+	})
+}
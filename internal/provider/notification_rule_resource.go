@@ -11,6 +11,7 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -18,6 +19,9 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &NotificationRuleResource{}
 var _ resource.ResourceWithImportState = &NotificationRuleResource{}
+var _ resource.ResourceWithIdentity = &NotificationRuleResource{}
+var _ resource.ResourceWithUpgradeState = &NotificationRuleResource{}
+var _ resource.ResourceWithValidateConfig = &NotificationRuleResource{}
 
 func NewNotificationRuleResource() resource.Resource {
 	return &NotificationRuleResource{}
@@ -32,12 +36,26 @@ func (r *NotificationRuleResource) Metadata(_ context.Context, req resource.Meta
 	resp.TypeName = req.ProviderTypeName + "_notification_rule"
 }
 
-func (r *NotificationRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *NotificationRuleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:    0,
 		Attributes: schemaAttributes.NotificationRuleResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
+func (r *NotificationRuleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.NotificationRuleResourceIdentityAttributes,
+	}
+}
+
+func (r *NotificationRuleResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r)
+}
+
 func (r *NotificationRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring NotificationRuleResource")
 
@@ -58,6 +76,16 @@ func (r *NotificationRuleResource) Configure(ctx context.Context, req resource.C
 	tflog.Trace(ctx, "Configured NotificationRuleResource")
 }
 
+func (r *NotificationRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data dataModels.NotificationRuleModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateTimeRestriction(ctx, data.TimeRestriction, path.Root("time_restriction"), &resp.Diagnostics)
+}
+
 func (r *NotificationRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Trace(ctx, "Creating NotificationRuleResource")
 
@@ -67,6 +95,10 @@ func (r *NotificationRuleResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Convert to DTO
 	notificationRuleDto, err := NotificationRuleModelToDto(ctx, data)
 	if err != nil {
@@ -77,8 +109,8 @@ func (r *NotificationRuleResource) Create(ctx context.Context, req resource.Crea
 
 	// Create notification rule
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
-		JoinBaseUrl("/v1/notification-rules").
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(notificationRulesBaseUrl(data.UserId.ValueString())).
 		Method(httpClient.POST).
 		SetBody(notificationRuleDto).
 		SetBodyParseObject(&notificationRuleDto).
@@ -110,23 +142,30 @@ func (r *NotificationRuleResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	// Update state with response
-	data = NotificationRuleDtoToModel(ctx, notificationRuleDto)
+	data = NotificationRuleDtoToModel(ctx, data.UserId, notificationRuleDto)
+	data.Timeouts = timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *NotificationRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data dataModels.NotificationRuleModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Trace(ctx, "Reading NotificationRuleResource")
 
 	var notificationRuleDto dto.NotificationRuleDto
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
-		JoinBaseUrl(fmt.Sprintf("/v1/notification-rules/%s", data.ID.ValueString())).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("%s/%s", notificationRulesBaseUrl(data.UserId.ValueString()), data.ID.ValueString())).
 		Method(httpClient.GET).
 		SetBodyParseObject(&notificationRuleDto).
 		Send()
@@ -161,7 +200,8 @@ func (r *NotificationRuleResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	data = NotificationRuleDtoToModel(ctx, notificationRuleDto)
+	data = NotificationRuleDtoToModel(ctx, data.UserId, notificationRuleDto)
+	data.Timeouts = timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -172,6 +212,10 @@ func (r *NotificationRuleResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Convert to DTO
 	notificationRuleDto, err := NotificationRuleModelToDto(ctx, data)
 	if err != nil {
@@ -182,8 +226,8 @@ func (r *NotificationRuleResource) Update(ctx context.Context, req resource.Upda
 
 	// Update notification rule
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
-		JoinBaseUrl(fmt.Sprintf("/v1/notification-rules/%s", data.ID.ValueString())).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("%s/%s", notificationRulesBaseUrl(data.UserId.ValueString()), data.ID.ValueString())).
 		Method(httpClient.PATCH).
 		SetBody(notificationRuleDto).
 		SetBodyParseObject(&notificationRuleDto).
@@ -214,8 +258,10 @@ func (r *NotificationRuleResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	data = NotificationRuleDtoToModel(ctx, notificationRuleDto)
+	data = NotificationRuleDtoToModel(ctx, data.UserId, notificationRuleDto)
+	data.Timeouts = timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *NotificationRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -225,10 +271,13 @@ func (r *NotificationRuleResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete notification rule
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
-		JoinBaseUrl(fmt.Sprintf("/v1/notification-rules/%s", data.ID.ValueString())).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("%s/%s", notificationRulesBaseUrl(data.UserId.ValueString()), data.ID.ValueString())).
 		Method(httpClient.DELETE).
 		Send()
 
@@ -259,5 +308,17 @@ func (r *NotificationRuleResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *NotificationRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		return
+	}
+
+	importUserScopedResource(ctx, req, resp)
+}
+
+func notificationRulesBaseUrl(userId string) string {
+	if userId == "" {
+		return "/v1/notification-rules"
+	}
+	return fmt.Sprintf("/v1/users/%s/notification-rules", userId)
 }
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/google/uuid"
@@ -365,3 +366,25 @@ resource "atlassian-operations_notification_rule" "example" {
 		},
 	})
 }
+
+func TestAccNotificationRuleResource_InvalidTimeRestriction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_notification_rule" "example" {
+  name        = "Critical Incident Alert"
+  action_type = "create-alert"
+
+  time_restriction = {
+    type = "time-of-day"
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Time Restriction"),
+			},
+		},
+	})
+}
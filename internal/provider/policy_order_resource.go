@@ -0,0 +1,351 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &PolicyOrderResource{}
+	_ resource.ResourceWithConfigure      = &PolicyOrderResource{}
+	_ resource.ResourceWithImportState    = &PolicyOrderResource{}
+	_ resource.ResourceWithIdentity       = &PolicyOrderResource{}
+	_ resource.ResourceWithValidateConfig = &PolicyOrderResource{}
+	_ resource.ResourceWithUpgradeState   = &PolicyOrderResource{}
+)
+
+type PolicyOrderResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func NewPolicyOrderResource() resource.Resource {
+	return &PolicyOrderResource{}
+}
+
+func (r *PolicyOrderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_order"
+}
+
+func (r *PolicyOrderResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     0,
+		Description: "Manage the evaluation order of the alert or notification policies of a team, or of the global alert policies.",
+		Attributes:  schemaAttributes.PolicyOrderResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *PolicyOrderResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.PolicyOrderResourceIdentityAttributes,
+	}
+}
+
+func (r *PolicyOrderResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r)
+}
+
+func (r *PolicyOrderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring PolicyOrderResource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+	tflog.Trace(ctx, "Configured PolicyOrderResource")
+}
+
+func (r *PolicyOrderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data dataModels.PolicyOrderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.ValueString() == "notification" && data.TeamID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("team_id"),
+			"Missing Team ID",
+			"Notification policies always belong to a team, team_id must be set when type is 'notification'.",
+		)
+	}
+}
+
+func (r *PolicyOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "Creating PolicyOrderResource")
+
+	var data dataModels.PolicyOrderModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.Append(r.applyPolicyOrder(ctx, &data, "create")...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created PolicyOrderResource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *PolicyOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data dataModels.PolicyOrderModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Trace(ctx, "Reading PolicyOrderResource")
+
+	policies, err := listOrderedPolicies(ctx, r.clientConfiguration, data.TeamID.ValueString(), data.Type.ValueString())
+	if listStatusCode(err) == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. %s", err))
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	var managedIds []string
+	if !(data.PolicyIDs.IsNull() || data.PolicyIDs.IsUnknown()) {
+		resp.Diagnostics.Append(data.PolicyIDs.ElementsAs(ctx, &managedIds, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	policyIdsValue, diags := types.ListValueFrom(ctx, types.StringType, leadingPolicyIds(policies, len(managedIds)))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.PolicyIDs = policyIdsValue
+	data.ID = types.StringValue(policyOrderId(data.TeamID.ValueString(), data.Type.ValueString()))
+
+	tflog.Trace(ctx, "Read PolicyOrderResource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PolicyOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "Updating PolicyOrderResource")
+
+	var data dataModels.PolicyOrderModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.Append(r.applyPolicyOrder(ctx, &data, "update")...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updated PolicyOrderResource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *PolicyOrderResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// The order of the policies is kept as is, the resource is only removed from the state
+	tflog.Trace(ctx, "Deleted PolicyOrderResource")
+}
+
+func (r *PolicyOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var policyType, teamId types.String
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("type"), &policyType)...)
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("team_id"), &teamId)...)
+		id := policyType.ValueString()
+		if teamId.ValueString() != "" {
+			id += "," + teamId.ValueString()
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) > 2 || idParts[0] == "" || (len(idParts) == 2 && idParts[1] == "") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: type,team_id or type,team:<team name> (for team policies); or: alert (for global alert policies). Got: %q", req.ID),
+		)
+		return
+	}
+	if len(idParts) == 2 {
+		if names, ok := parseImportReference(idParts[1], "team"); ok {
+			teamId, err := resolveTeamId(ctx, r.clientConfiguration, names[0])
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to Resolve Import Identifier", err.Error())
+				return
+			}
+			idParts[1] = teamId
+		}
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strings.Join(idParts, ","))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), idParts[0])...)
+	if len(idParts) == 2 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), idParts[1])...)
+	}
+}
+
+// applyPolicyOrder moves the planned policies into the requested positions, one change-order call per misplaced policy.
+func (r *PolicyOrderResource) applyPolicyOrder(ctx context.Context, data *dataModels.PolicyOrderModel, operation string) (diags diag.Diagnostics) {
+	teamId := data.TeamID.ValueString()
+	policyType := data.Type.ValueString()
+
+	var desiredIds []string
+	diags.Append(data.PolicyIDs.ElementsAs(ctx, &desiredIds, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	policies, err := listOrderedPolicies(ctx, r.clientConfiguration, teamId, policyType)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to %s policy order. %s", operation, err))
+		diags.AddError("Client Error", fmt.Sprintf("Unable to %s policy order. %s", operation, err))
+		return
+	}
+
+	currentIds := make([]string, len(policies))
+	for i, policy := range policies {
+		currentIds[i] = policy.ID
+	}
+
+	for targetIndex, policyId := range desiredIds {
+		currentIndex := slices.Index(currentIds, policyId)
+		if currentIndex == -1 {
+			diags.AddAttributeError(
+				path.Root("policy_ids"),
+				"Policy Not Found",
+				fmt.Sprintf("Unable to %s policy order, the %s policy %s does not exist.", operation, policyType, policyId),
+			)
+			return
+		}
+		if currentIndex == targetIndex {
+			continue
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Moving %s policy %s from index %d to %d", policyType, policyId, currentIndex, targetIndex))
+		if err := changePolicyOrder(ctx, r.clientConfiguration, teamId, policyType, policyId, targetIndex); err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to %s policy order. %s", operation, err))
+			diags.AddError("Client Error", fmt.Sprintf("Unable to %s policy order. %s", operation, err))
+			return
+		}
+
+		currentIds = slices.Delete(currentIds, currentIndex, currentIndex+1)
+		currentIds = slices.Insert(currentIds, targetIndex, policyId)
+	}
+
+	data.ID = types.StringValue(policyOrderId(teamId, policyType))
+	return
+}
+
+// leadingPolicyIds returns the IDs of the first count policies, or of all policies if count is 0 as after an import.
+// The listed policies must occupy the first positions, so a policy that was moved out of them or inserted before
+// them shows up as drift.
+func leadingPolicyIds(policies []dto.BaseAlertPolicyDto, count int) []string {
+	if count == 0 || count > len(policies) {
+		count = len(policies)
+	}
+
+	policyIds := make([]string, count)
+	for i, policy := range policies[:count] {
+		policyIds[i] = policy.ID
+	}
+	return policyIds
+}
+
+func policyOrderId(teamId string, policyType string) string {
+	if teamId == "" {
+		return policyType
+	}
+	return policyType + "," + teamId
+}
+
+func policiesBaseUrl(teamId string) string {
+	if teamId == "" {
+		return "/v1/alerts/policies"
+	}
+	return fmt.Sprintf("/v1/teams/%s/policies", teamId)
+}
+
+// listOrderedPolicies pages through the policies of the given type and returns them sorted by their order.
+func listOrderedPolicies(ctx context.Context, configuration dto.AtlassianOpsProviderModel, teamId string, policyType string) ([]dto.BaseAlertPolicyDto, error) {
+	policies, err := listAll[dto.BaseAlertPolicyDto](ctx, configuration, policiesBaseUrl(teamId), map[string]string{"type": policyType}, policyType+" policy")
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(policies, func(i, j int) bool {
+		return policies[i].Order < policies[j].Order
+	})
+	return policies, nil
+}
+
+func changePolicyOrder(ctx context.Context, configuration dto.AtlassianOpsProviderModel, teamId string, policyType string, policyId string, targetIndex int) error {
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(ctx, configuration).
+		JoinBaseUrl(fmt.Sprintf("%s/%s/change-order", policiesBaseUrl(teamId), policyId)).
+		Method(httpClient.POST).
+		SetBody(dto.PolicyChangeOrderDto{TargetIndex: targetIndex}).
+		Send()
+
+	if httpResp == nil {
+		return fmt.Errorf("unable to change order of %s policy %s, got nil response", policyType, policyId)
+	}
+	if httpResp.IsError() {
+		if errorResponse := httpResp.GetErrorBody(); errorResponse != nil {
+			return fmt.Errorf("unable to change order of %s policy %s, status code: %d. Got response: %s", policyType, policyId, httpResp.GetStatusCode(), *errorResponse)
+		}
+		return fmt.Errorf("unable to change order of %s policy %s, got http response: %d", policyType, policyId, httpResp.GetStatusCode())
+	}
+	if err != nil {
+		return fmt.Errorf("unable to change order of %s policy %s, got error: %s", policyType, policyId, err)
+	}
+	return nil
+}
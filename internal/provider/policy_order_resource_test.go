@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"os"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func policyOrderTestConfig(teamName string, organizationId string, emailPrimary string, policyIds string) string {
+	return providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  display_name = "` + teamName + `"
+  description = "team description"
+  organization_id = "` + organizationId + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+       account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_alert_policy" "first" {
+  name        = "first"
+  team_id     = atlassian-operations_team.example.id
+  type        = "alert"
+  enabled     = true
+  message     = "{{message}}"
+}
+
+resource "atlassian-operations_alert_policy" "second" {
+  name        = "second"
+  team_id     = atlassian-operations_team.example.id
+  type        = "alert"
+  enabled     = true
+  message     = "{{message}}"
+  depends_on  = [atlassian-operations_alert_policy.first]
+}
+
+resource "atlassian-operations_alert_policy" "third" {
+  name        = "third"
+  team_id     = atlassian-operations_team.example.id
+  type        = "alert"
+  enabled     = true
+  message     = "{{message}}"
+  depends_on  = [atlassian-operations_alert_policy.second]
+}
+
+resource "atlassian-operations_policy_order" "test" {
+  team_id    = atlassian-operations_team.example.id
+  type       = "alert"
+  policy_ids = ` + policyIds + `
+}
+`
+}
+
+func TestAccPolicyOrderResource(t *testing.T) {
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: policyOrderTestConfig(teamName, organizationId, emailPrimary, `[
+    atlassian-operations_alert_policy.third.id,
+    atlassian-operations_alert_policy.first.id,
+    atlassian-operations_alert_policy.second.id,
+  ]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_policy_order.test", "type", "alert"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_policy_order.test", "team_id", "atlassian-operations_team.example", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_policy_order.test", "policy_ids.#", "3"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_policy_order.test", "policy_ids.0", "atlassian-operations_alert_policy.third", "id"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_policy_order.test", "policy_ids.1", "atlassian-operations_alert_policy.first", "id"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_policy_order.test", "policy_ids.2", "atlassian-operations_alert_policy.second", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "atlassian-operations_policy_order.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return "alert," + state.RootModule().Resources["atlassian-operations_policy_order.test"].Primary.Attributes["team_id"], nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: policyOrderTestConfig(teamName, organizationId, emailPrimary, `[
+    atlassian-operations_alert_policy.second.id,
+    atlassian-operations_alert_policy.third.id,
+  ]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_policy_order.test", "policy_ids.#", "2"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_policy_order.test", "policy_ids.0", "atlassian-operations_alert_policy.second", "id"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_policy_order.test", "policy_ids.1", "atlassian-operations_alert_policy.third", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccPolicyOrderResource_NotificationWithoutTeam(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_policy_order" "test" {
+  type       = "notification"
+  policy_ids = ["xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Missing Team ID"),
+			},
+		},
+	})
+}
+
+func TestLeadingPolicyIds(t *testing.T) {
+	policies := []dto.BaseAlertPolicyDto{{ID: "a", Order: 0}, {ID: "b", Order: 1}, {ID: "c", Order: 2}}
+
+	testCases := []struct {
+		count    int
+		expected []string
+	}{
+		{count: 2, expected: []string{"a", "b"}},
+		{count: 3, expected: []string{"a", "b", "c"}},
+		{count: 0, expected: []string{"a", "b", "c"}},
+		{count: 4, expected: []string{"a", "b", "c"}},
+	}
+
+	for _, testCase := range testCases {
+		if policyIds := leadingPolicyIds(policies, testCase.count); !slices.Equal(policyIds, testCase.expected) {
+			t.Errorf("leadingPolicyIds(%d) = %q; want %q", testCase.count, policyIds, testCase.expected)
+		}
+	}
+}
@@ -9,6 +9,9 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -19,7 +22,10 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &atlassianOpsProvider{}
+	_ provider.Provider                       = &atlassianOpsProvider{}
+	_ provider.ProviderWithEphemeralResources = &atlassianOpsProvider{}
+	_ provider.ProviderWithListResources      = &atlassianOpsProvider{}
+	_ provider.ProviderWithFunctions          = &atlassianOpsProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -189,6 +195,8 @@ func (p *atlassianOpsProvider) Configure(ctx context.Context, req provider.Confi
 	// type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+	resp.ListResourceData = client
 
 	tflog.Info(ctx, "Configured atlassian-operations clientConfiguration", map[string]any{"success": true})
 }
@@ -199,6 +207,9 @@ func (p *atlassianOpsProvider) DataSources(_ context.Context) []func() datasourc
 		NewUserDataSource,
 		NewTeamDataSource,
 		NewScheduleDataSource,
+		NewServiceDataSource,
+		NewServicesDataSource,
+		NewCustomRoleRightsDataSource,
 	}
 }
 
@@ -221,5 +232,39 @@ func (p *atlassianOpsProvider) Resources(_ context.Context) []func() resource.Re
 		NewIntegrationActionResource,
 		NewServiceResource,
 		NewMaintenanceResource,
+		NewPolicyOrderResource,
+		NewRoutingRulesResource,
+		NewServiceRelationshipResource,
+		NewIncomingCallRoutingResource,
+		NewTeamRoleResource,
+		NewTeamMemberRoleResource,
+		NewUserQuietHoursResource,
+		NewUserForwardingRuleResource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *atlassianOpsProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewIntegrationApiKeyEphemeralResource,
+	}
+}
+
+// ListResources defines the list resources implemented in the provider.
+func (p *atlassianOpsProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewTeamListResource,
+		NewScheduleListResource,
+		NewEscalationListResource,
+		NewApiIntegrationListResource,
+		NewEmailIntegrationListResource,
+	}
+}
+
+// Functions defines the provider-defined functions implemented in the provider.
+func (p *atlassianOpsProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewBusinessHoursFunction,
+		NewDurationFunction,
 	}
 }
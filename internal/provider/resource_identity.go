@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// setIdentityFromState copies the identity of a resource from its state. The identity attributes of all resources
+// are strings named after the state attributes they are copied from, such as id and team_id. Nothing is copied when
+// no identity is requested, e.g. for older Terraform versions.
+func setIdentityFromState(ctx context.Context, state tfsdk.State, identity *tfsdk.ResourceIdentity, diags *diag.Diagnostics) {
+	if identity == nil {
+		return
+	}
+
+	for name := range identity.Schema.GetAttributes() {
+		var value types.String
+		diags.Append(state.GetAttribute(ctx, path.Root(name), &value)...)
+		diags.Append(identity.SetAttribute(ctx, path.Root(name), value)...)
+	}
+}
+
+// importStateFromIdentity imports a resource by the identity attribute of an import block instead of an import
+// identifier. Terraform ensures the attributes required for import are set, empty values are rejected here.
+func importStateFromIdentity(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	for name := range req.Identity.Schema.GetAttributes() {
+		var value types.String
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(name), &value)...)
+		if !value.IsNull() && value.ValueString() == "" {
+			resp.Diagnostics.AddError(
+				"Invalid Resource Identity",
+				fmt.Sprintf("The %s attribute of the resource identity must not be empty.", name),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setStateFromIdentity(ctx, req.Identity, &resp.State, &resp.Diagnostics)
+}
+
+// setStateFromIdentity copies the identity of a resource into its state, ready to be read.
+func setStateFromIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, state *tfsdk.State, diags *diag.Diagnostics) {
+	for name := range identity.Schema.GetAttributes() {
+		var value types.String
+		diags.Append(identity.GetAttribute(ctx, path.Root(name), &value)...)
+		diags.Append(state.SetAttribute(ctx, path.Root(name), value)...)
+	}
+}
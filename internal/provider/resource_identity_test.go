@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestResourceIdentities ensures every resource has an identity made of string attributes of its state, and that the
+// identity copied from the state fixture of its current schema version is copied back into the state unchanged.
+func TestResourceIdentities(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")()
+
+	for _, newResource := range p.Resources(ctx) {
+		res := newResource()
+
+		var metadataResp resource.MetadataResponse
+		res.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "atlassian-operations"}, &metadataResp)
+		name := strings.TrimPrefix(metadataResp.TypeName, "atlassian-operations_")
+
+		t.Run(name, func(t *testing.T) {
+			withIdentity, ok := res.(resource.ResourceWithIdentity)
+			if !ok {
+				t.Fatalf("%s does not implement resource.ResourceWithIdentity", metadataResp.TypeName)
+			}
+
+			var schemaResp resource.SchemaResponse
+			res.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			var identitySchemaResp resource.IdentitySchemaResponse
+			withIdentity.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+			for attributeName := range identitySchemaResp.IdentitySchema.Attributes {
+				if _, ok := schemaResp.Schema.Attributes[attributeName].(schema.StringAttribute); !ok {
+					t.Fatalf("identity attribute %q is not a string attribute of the resource", attributeName)
+				}
+			}
+
+			fixture := readStateUpgradeFixture(t, name, schemaResp.Schema.Version)
+			raw, err := (&tfprotov6.RawState{JSON: fixture}).Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+			if err != nil {
+				t.Fatalf("fixture does not match the current schema: %s", err)
+			}
+
+			var diags diag.Diagnostics
+			identity := &tfsdk.ResourceIdentity{
+				Schema: identitySchemaResp.IdentitySchema,
+				Raw:    tftypes.NewValue(identitySchemaResp.IdentitySchema.Type().TerraformType(ctx), nil),
+			}
+			setIdentityFromState(ctx, tfsdk.State{Schema: schemaResp.Schema, Raw: raw}, identity, &diags)
+
+			imported := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			setStateFromIdentity(ctx, identity, &imported, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if identity.Raw.IsFullyNull() {
+				t.Fatal("identity copied from the state is null")
+			}
+
+			for attributeName := range identitySchemaResp.IdentitySchema.Attributes {
+				attributePath := tftypes.NewAttributePath().WithAttributeName(attributeName)
+				expected, _, _ := tftypes.WalkAttributePath(raw, attributePath)
+				actual, _, _ := tftypes.WalkAttributePath(imported.Raw, attributePath)
+				if !actual.(tftypes.Value).Equal(expected.(tftypes.Value)) {
+					t.Errorf("identity attribute %q was imported as %s, want %s", attributeName, actual, expected)
+				}
+			}
+		})
+	}
+}
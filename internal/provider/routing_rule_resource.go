@@ -11,13 +11,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"strings"
 )
 
 var _ resource.Resource = &RoutingRuleResource{}
 var _ resource.ResourceWithImportState = &RoutingRuleResource{}
+var _ resource.ResourceWithIdentity = &RoutingRuleResource{}
+var _ resource.ResourceWithUpgradeState = &RoutingRuleResource{}
+var _ resource.ResourceWithValidateConfig = &RoutingRuleResource{}
 
 func NewRoutingRuleResource() resource.Resource {
 	return &RoutingRuleResource{}
@@ -31,12 +34,26 @@ func (r *RoutingRuleResource) Metadata(_ context.Context, req resource.MetadataR
 	resp.TypeName = req.ProviderTypeName + "_routing_rule"
 }
 
-func (r *RoutingRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *RoutingRuleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:    0,
 		Attributes: schemaAttributes.RoutingRuleResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
+func (r *RoutingRuleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.RoutingRuleResourceIdentityAttributes,
+	}
+}
+
+func (r *RoutingRuleResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r)
+}
+
 func (r *RoutingRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -54,6 +71,16 @@ func (r *RoutingRuleResource) Configure(_ context.Context, req resource.Configur
 	r.clientConfiguration = client
 }
 
+func (r *RoutingRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data dataModels.RoutingRuleModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateTimeRestriction(ctx, data.TimeRestriction, path.Root("time_restriction"), &resp.Diagnostics)
+}
+
 func (r *RoutingRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data dataModels.RoutingRuleModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -61,12 +88,16 @@ func (r *RoutingRuleResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Convert to DTO
 	ruleDto := RoutingRuleModelToDto(ctx, data)
 
 	// Create routing rule
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/routing-rules", data.TeamID.ValueString())).
 		Method(httpClient.POST).
 		SetBody(ruleDto).
@@ -77,20 +108,27 @@ func (r *RoutingRuleResource) Create(ctx context.Context, req resource.CreateReq
 
 	// Update state with response
 	data = RoutingRuleDtoToModel(data.TeamID.ValueString(), ruleDto)
+	data.Timeouts = timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *RoutingRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data dataModels.RoutingRuleModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get routing rule
 	var ruleDto dto.RoutingRuleDto
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/routing-rules/%s", data.TeamID.ValueString(), data.ID.ValueString())).
 		Method(httpClient.GET).
 		SetBodyParseObject(&ruleDto).
@@ -108,6 +146,7 @@ func (r *RoutingRuleResource) Read(ctx context.Context, req resource.ReadRequest
 
 	// Update state
 	data = RoutingRuleDtoToModel(data.TeamID.ValueString(), ruleDto)
+	data.Timeouts = timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -118,12 +157,16 @@ func (r *RoutingRuleResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Convert to DTO
 	ruleDto := RoutingRuleModelToDto(ctx, data)
 
 	// Update routing rule
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/routing-rules/%s", data.TeamID.ValueString(), data.ID.ValueString())).
 		Method(httpClient.PATCH).
 		SetBody(ruleDto).
@@ -134,7 +177,9 @@ func (r *RoutingRuleResource) Update(ctx context.Context, req resource.UpdateReq
 
 	// Update state
 	data = RoutingRuleDtoToModel(data.TeamID.ValueString(), ruleDto)
+	data.Timeouts = timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *RoutingRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -144,9 +189,12 @@ func (r *RoutingRuleResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete routing rule
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/routing-rules/%s", data.TeamID.ValueString(), data.ID.ValueString())).
 		Method(httpClient.DELETE).
 		Send()
@@ -155,16 +203,16 @@ func (r *RoutingRuleResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *RoutingRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: id,team_id. Got: %q", req.ID),
-		)
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
 		return
 	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), idParts[1])...)
+
+	importTeamScopedResource(ctx, r.clientConfiguration, req, resp, "routing-rule", func(teamId string) string {
+		return fmt.Sprintf("/v1/teams/%s/routing-rules", teamId)
+	}, nil, func(rule dto.RoutingRuleDto) (string, string) {
+		return rule.Name, rule.ID
+	})
 }
 
 func handleHttpResponse(httpResp *httpClient.Response, err error, s string, d *diag.Diagnostics, ctx context.Context) {
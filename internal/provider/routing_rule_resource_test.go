@@ -4,6 +4,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -244,3 +245,36 @@ resource "atlassian-operations_routing_rule" "example" {
 		},
 	})
 }
+
+func TestAccRoutingRuleResource_InvalidTimeRestriction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_routing_rule" "example" {
+  team_id  = "00000000-0000-0000-0000-000000000000"
+  name     = "Example Routing Rule"
+  timezone = "Europe/Istanbul"
+
+  time_restriction = {
+    type = "weekday-and-time-of-day"
+    restriction = {
+      start_hour = 9
+      end_hour = 17
+      start_min = 0
+      end_min = 0
+    }
+  }
+
+  notify = {
+    type = "none"
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Time Restriction"),
+			},
+		},
+	})
+}
@@ -0,0 +1,532 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &RoutingRulesResource{}
+	_ resource.ResourceWithConfigure      = &RoutingRulesResource{}
+	_ resource.ResourceWithImportState    = &RoutingRulesResource{}
+	_ resource.ResourceWithIdentity       = &RoutingRulesResource{}
+	_ resource.ResourceWithValidateConfig = &RoutingRulesResource{}
+	_ resource.ResourceWithModifyPlan     = &RoutingRulesResource{}
+	_ resource.ResourceWithUpgradeState   = &RoutingRulesResource{}
+)
+
+func NewRoutingRulesResource() resource.Resource {
+	return &RoutingRulesResource{}
+}
+
+type RoutingRulesResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (r *RoutingRulesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_routing_rules"
+}
+
+func (r *RoutingRulesResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     0,
+		Description: "Manage the complete, ordered list of routing rules of a team, including who its default routing rule notifies. Do not combine with atlassian-operations_routing_rule resources for the same team.",
+		Attributes:  schemaAttributes.RoutingRulesResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *RoutingRulesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.RoutingRulesResourceIdentityAttributes,
+	}
+}
+
+func (r *RoutingRulesResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r)
+}
+
+func (r *RoutingRulesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+}
+
+func (r *RoutingRulesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data dataModels.RoutingRulesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Rules.IsNull() || data.Rules.IsUnknown() {
+		return
+	}
+
+	var rules []dataModels.RoutingRulesRuleModel
+	resp.Diagnostics.Append(data.Rules.ElementsAs(ctx, &rules, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := make(map[string]int)
+	for i, rule := range rules {
+		validateTimeRestriction(ctx, rule.TimeRestriction, path.Root("rules").AtListIndex(i).AtName("time_restriction"), &resp.Diagnostics)
+
+		if rule.Name.IsNull() || rule.Name.IsUnknown() {
+			continue
+		}
+		if previous, ok := names[rule.Name.ValueString()]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rules").AtListIndex(i).AtName("name"),
+				"Duplicate Routing Rule Name",
+				fmt.Sprintf("The routing rule name '%s' is already used by the rule at index %d. Names are used to match rules to existing ones and must be unique.", rule.Name.ValueString(), previous),
+			)
+			continue
+		}
+		names[rule.Name.ValueString()] = i
+	}
+}
+
+// ModifyPlan matches the planned rules to the rules in state by name, so that unchanged rules keep their id and
+// computed values, and only new rules are shown with an unknown id.
+func (r *RoutingRulesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state dataModels.RoutingRulesModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Rules.IsUnknown() || state.Rules.IsNull() {
+		return
+	}
+
+	var plannedRules, stateRules []dataModels.RoutingRulesRuleModel
+	resp.Diagnostics.Append(plan.Rules.ElementsAs(ctx, &plannedRules, false)...)
+	resp.Diagnostics.Append(state.Rules.ElementsAs(ctx, &stateRules, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateRulesByName := make(map[string]dataModels.RoutingRulesRuleModel, len(stateRules))
+	for _, rule := range stateRules {
+		stateRulesByName[rule.Name.ValueString()] = rule
+	}
+
+	ruleValues := make([]attr.Value, len(plannedRules))
+	for i, rule := range plannedRules {
+		if stateRule, ok := stateRulesByName[rule.Name.ValueString()]; ok {
+			rule.ID = stateRule.ID
+			if rule.Timezone.IsUnknown() {
+				rule.Timezone = stateRule.Timezone
+			}
+			if rule.Criteria.IsUnknown() {
+				rule.Criteria = stateRule.Criteria
+			}
+			if rule.TimeRestriction.IsUnknown() {
+				rule.TimeRestriction = stateRule.TimeRestriction
+			}
+		} else {
+			rule.ID = types.StringUnknown()
+		}
+		ruleValues[i] = rule.AsValue()
+	}
+
+	rulesValue, diags := types.ListValue(types.ObjectType{AttrTypes: dataModels.RoutingRulesRuleModelMap}, ruleValues)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rules"), rulesValue)...)
+
+	if plan.DefaultNotify.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("default_notify"), state.DefaultNotify)...)
+	}
+}
+
+func (r *RoutingRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "Creating RoutingRulesResource")
+
+	var data dataModels.RoutingRulesModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.applyRoutingRules(ctx, data, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.readRoutingRules(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created RoutingRulesResource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *RoutingRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data dataModels.RoutingRulesModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	if found := r.readRoutingRules(ctx, &data, &resp.Diagnostics); !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoutingRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "Updating RoutingRulesResource")
+
+	var data, state dataModels.RoutingRulesModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	var stateRules []dataModels.RoutingRulesRuleModel
+	resp.Diagnostics.Append(state.Rules.ElementsAs(ctx, &stateRules, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyRoutingRules(ctx, data, stateRules)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.readRoutingRules(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updated RoutingRulesResource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *RoutingRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data dataModels.RoutingRulesModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	var rules []dataModels.RoutingRulesRuleModel
+	resp.Diagnostics.Append(data.Rules.ElementsAs(ctx, &rules, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The default routing rule can not be deleted and is left as is
+	for _, rule := range rules {
+		httpResp, err := httpClientHelpers.
+			GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+			JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/routing-rules/%s", data.TeamID.ValueString(), rule.ID.ValueString())).
+			Method(httpClient.DELETE).
+			Send()
+
+		if httpResp != nil && httpResp.GetStatusCode() == 404 {
+			continue
+		}
+		handleHttpResponse(httpResp, err, "delete routing rule", &resp.Diagnostics, ctx)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+func (r *RoutingRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var teamId types.String
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("team_id"), &teamId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), teamId)...)
+		return
+	}
+
+	teamId := req.ID
+	if names, ok := parseImportReference(req.ID, "team"); ok {
+		var err error
+		teamId, err = resolveTeamId(ctx, r.clientConfiguration, names[0])
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Resolve Import Identifier", err.Error())
+			return
+		}
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), teamId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamId)...)
+}
+
+// readRoutingRules replaces data, except for its timeouts, with the routing rules of the team. It returns false if
+// the team does not exist.
+func (r *RoutingRulesResource) readRoutingRules(ctx context.Context, data *dataModels.RoutingRulesModel, diagnostics *diag.Diagnostics) bool {
+	teamId := data.TeamID.ValueString()
+
+	rules, err := listRoutingRules(ctx, r.clientConfiguration, teamId)
+	if listStatusCode(err) == 404 {
+		return false
+	}
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. %s", err))
+		diagnostics.AddError("Client Error", err.Error())
+		return true
+	}
+
+	timeouts := data.Timeouts
+	*data = RoutingRulesDtoToModel(teamId, rules)
+	data.Timeouts = timeouts
+	return true
+}
+
+// applyRoutingRules reconciles the routing rules of the team with the planned ones using as few calls as possible:
+// rules are matched by id or name, only changed rules are updated, and only misplaced rules are moved.
+// priorRules holds the rules in state, if any, and is used to skip updating unchanged rules.
+func (r *RoutingRulesResource) applyRoutingRules(ctx context.Context, data dataModels.RoutingRulesModel, priorRules []dataModels.RoutingRulesRuleModel) (diags diag.Diagnostics) {
+	teamId := data.TeamID.ValueString()
+	rulesUrl := fmt.Sprintf("/v1/teams/%s/routing-rules", teamId)
+
+	var plannedRules []dataModels.RoutingRulesRuleModel
+	diags.Append(data.Rules.ElementsAs(ctx, &plannedRules, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	currentRules, err := listRoutingRules(ctx, r.clientConfiguration, teamId)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. %s", err))
+		diags.AddError("Client Error", err.Error())
+		return
+	}
+
+	var defaultRule *dto.RoutingRuleDto
+	currentById := make(map[string]dto.RoutingRuleDto)
+	currentByName := make(map[string]dto.RoutingRuleDto)
+	for i, rule := range currentRules {
+		if rule.IsDefault {
+			defaultRule = &currentRules[i]
+			continue
+		}
+		currentById[rule.ID] = rule
+		currentByName[rule.Name] = rule
+	}
+
+	priorById := make(map[string]dataModels.RoutingRulesRuleModel, len(priorRules))
+	for _, rule := range priorRules {
+		priorById[rule.ID.ValueString()] = rule
+	}
+
+	// Match the planned rules to the existing ones and update those that changed
+	desiredIds := make([]string, len(plannedRules))
+	for i, rule := range plannedRules {
+		existing, ok := currentById[rule.ID.ValueString()]
+		if !ok {
+			existing, ok = currentByName[rule.Name.ValueString()]
+		}
+		if !ok {
+			continue
+		}
+		desiredIds[i] = existing.ID
+		rule.ID = types.StringValue(existing.ID)
+
+		if prior, ok := priorById[existing.ID]; ok && prior.AsValue().Equal(rule.AsValue()) {
+			continue
+		}
+
+		ruleDto := RoutingRulesRuleModelToDto(ctx, rule)
+		httpResp, err := httpClientHelpers.
+			GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+			JoinBaseUrl(fmt.Sprintf("%s/%s", rulesUrl, existing.ID)).
+			Method(httpClient.PATCH).
+			SetBody(ruleDto).
+			Send()
+
+		handleHttpResponse(httpResp, err, "update routing rule", &diags, ctx)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	// Delete the rules that are no longer listed
+	for id := range currentById {
+		if slices.Contains(desiredIds, id) {
+			continue
+		}
+
+		httpResp, err := httpClientHelpers.
+			GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+			JoinBaseUrl(fmt.Sprintf("%s/%s", rulesUrl, id)).
+			Method(httpClient.DELETE).
+			Send()
+
+		handleHttpResponse(httpResp, err, "delete routing rule", &diags, ctx)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	// Create the new rules
+	for i, rule := range plannedRules {
+		if desiredIds[i] != "" {
+			continue
+		}
+
+		ruleDto := RoutingRulesRuleModelToDto(ctx, rule)
+		ruleDto.ID = ""
+		ruleDto.Order = int64(i)
+		httpResp, err := httpClientHelpers.
+			GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+			JoinBaseUrl(rulesUrl).
+			Method(httpClient.POST).
+			SetBody(ruleDto).
+			SetBodyParseObject(&ruleDto).
+			Send()
+
+		handleHttpResponse(httpResp, err, "create routing rule", &diags, ctx)
+		if diags.HasError() {
+			return
+		}
+		desiredIds[i] = ruleDto.ID
+	}
+
+	// Move the misplaced rules, the default rule always stays last
+	currentRules, err = listRoutingRules(ctx, r.clientConfiguration, teamId)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. %s", err))
+		diags.AddError("Client Error", err.Error())
+		return
+	}
+	currentIds := make([]string, 0, len(currentRules))
+	for _, rule := range currentRules {
+		if !rule.IsDefault {
+			currentIds = append(currentIds, rule.ID)
+		}
+	}
+
+	for targetIndex, ruleId := range desiredIds {
+		currentIndex := slices.Index(currentIds, ruleId)
+		if currentIndex == -1 || currentIndex == targetIndex {
+			continue
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Moving routing rule %s from index %d to %d", ruleId, currentIndex, targetIndex))
+		httpResp, err := httpClientHelpers.
+			GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+			JoinBaseUrl(fmt.Sprintf("%s/%s/change-order", rulesUrl, ruleId)).
+			Method(httpClient.PATCH).
+			SetBody(dto.RoutingRuleChangeOrderDto{Order: targetIndex}).
+			Send()
+
+		handleHttpResponse(httpResp, err, "change routing rule order", &diags, ctx)
+		if diags.HasError() {
+			return
+		}
+
+		currentIds = slices.Delete(currentIds, currentIndex, currentIndex+1)
+		currentIds = slices.Insert(currentIds, targetIndex, ruleId)
+	}
+
+	// Point the default rule to the planned target
+	if data.DefaultNotify.IsNull() || data.DefaultNotify.IsUnknown() {
+		return
+	}
+	if defaultRule == nil {
+		diags.AddAttributeError(
+			path.Root("default_notify"),
+			"Default Routing Rule Not Found",
+			fmt.Sprintf("The team %s does not have a default routing rule to update.", teamId),
+		)
+		return
+	}
+
+	var notify dataModels.RoutingRuleNotifyModel
+	diags.Append(data.DefaultNotify.As(ctx, &notify, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return
+	}
+	notifyDto := RoutingRuleNotifyModelToDto(notify)
+	if defaultRule.Notify != nil && strings.EqualFold(defaultRule.Notify.Type, notifyDto.Type) && defaultRule.Notify.ID == notifyDto.ID {
+		return
+	}
+
+	defaultRule.Notify = notifyDto
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("%s/%s", rulesUrl, defaultRule.ID)).
+		Method(httpClient.PATCH).
+		SetBody(defaultRule).
+		Send()
+
+	handleHttpResponse(httpResp, err, "update default routing rule", &diags, ctx)
+	return
+}
+
+// listRoutingRules pages through the routing rules of the team and returns them sorted by their order.
+func listRoutingRules(ctx context.Context, configuration dto.AtlassianOpsProviderModel, teamId string) ([]dto.RoutingRuleDto, error) {
+	rules, err := listAll[dto.RoutingRuleDto](ctx, configuration, fmt.Sprintf("/v1/teams/%s/routing-rules", teamId), nil, "routing rule")
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Order < rules[j].Order
+	})
+	return rules, nil
+}
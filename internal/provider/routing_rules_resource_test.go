@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func routingRulesTestConfig(teamName string, scheduleName string, organizationId string, emailPrimary string, rules string) string {
+	return providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_schedule" "example" {
+  name    = "` + scheduleName + `"
+  team_id = atlassian-operations_team.example.id
+}
+
+resource "atlassian-operations_routing_rules" "example" {
+  team_id = atlassian-operations_team.example.id
+
+  default_notify = {
+    type = "none"
+  }
+
+  rules = ` + rules + `
+}
+`
+}
+
+func TestAccRoutingRulesResource(t *testing.T) {
+	scheduleName := uuid.NewString()
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: routingRulesTestConfig(teamName, scheduleName, organizationId, emailPrimary, `[
+    {
+      name = "critical"
+      criteria = {
+        type = "match-all-conditions"
+        conditions = [
+          {
+            field          = "priority"
+            operation      = "equals"
+            expected_value = "P1"
+          }
+        ]
+      }
+      notify = {
+        type = "schedule"
+        id   = atlassian-operations_schedule.example.id
+      }
+    },
+    {
+      name = "quiet"
+      criteria = {
+        type = "match-all"
+      }
+      notify = {
+        type = "none"
+      }
+    }
+  ]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("atlassian-operations_routing_rules.example", "id", "atlassian-operations_team.example", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_routing_rules.example", "default_notify.type", "none"),
+					resource.TestCheckResourceAttr("atlassian-operations_routing_rules.example", "rules.#", "2"),
+					resource.TestCheckResourceAttr("atlassian-operations_routing_rules.example", "rules.0.name", "critical"),
+					resource.TestCheckResourceAttrSet("atlassian-operations_routing_rules.example", "rules.0.id"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_routing_rules.example", "rules.0.notify.id", "atlassian-operations_schedule.example", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_routing_rules.example", "rules.1.name", "quiet"),
+					resource.TestCheckResourceAttr("atlassian-operations_routing_rules.example", "rules.1.notify.type", "none"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "atlassian-operations_routing_rules.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: routingRulesTestConfig(teamName, scheduleName, organizationId, emailPrimary, `[
+    {
+      name = "business-hours"
+      criteria = {
+        type = "match-all"
+      }
+      time_restriction = {
+        type = "time-of-day"
+        restriction = {
+          start_hour = 9
+          end_hour   = 17
+          start_min  = 0
+          end_min    = 0
+        }
+      }
+      notify = {
+        type = "schedule"
+        id   = atlassian-operations_schedule.example.id
+      }
+    },
+    {
+      name = "critical"
+      criteria = {
+        type = "match-all-conditions"
+        conditions = [
+          {
+            field          = "priority"
+            operation      = "equals"
+            expected_value = "P1"
+          }
+        ]
+      }
+      notify = {
+        type = "schedule"
+        id   = atlassian-operations_schedule.example.id
+      }
+    }
+  ]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_routing_rules.example", "rules.#", "2"),
+					resource.TestCheckResourceAttr("atlassian-operations_routing_rules.example", "rules.0.name", "business-hours"),
+					resource.TestCheckResourceAttr("atlassian-operations_routing_rules.example", "rules.0.time_restriction.type", "time-of-day"),
+					resource.TestCheckResourceAttr("atlassian-operations_routing_rules.example", "rules.1.name", "critical"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRoutingRulesResource_DuplicateNames(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_routing_rules" "example" {
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  rules = [
+    {
+      name   = "duplicate"
+      notify = { type = "none" }
+    },
+    {
+      name   = "duplicate"
+      notify = { type = "none" }
+    }
+  ]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Duplicate Routing Rule Name"),
+			},
+		},
+	})
+}
@@ -82,7 +82,7 @@ func (d *ScheduleDataSource) Read(ctx context.Context, req datasource.ReadReques
 	tflog.Trace(ctx, "Sending HTTP request to JSM OPS API")
 
 	clientResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(d.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, d.clientConfiguration).
 		Method("GET").
 		JoinBaseUrl("/v1/schedules").
 		SetQueryParams(map[string]string{
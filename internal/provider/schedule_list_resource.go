@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &ScheduleListResource{}
+	_ list.ListResourceWithConfigure = &ScheduleListResource{}
+)
+
+func NewScheduleListResource() list.ListResource {
+	return &ScheduleListResource{}
+}
+
+// ScheduleListResource defines the list resource implementation.
+type ScheduleListResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (r *ScheduleListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule"
+}
+
+func (r *ScheduleListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the schedules of the site.",
+	}
+}
+
+func (r *ScheduleListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring ScheduleListResource")
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Unexpected List Resource Configure Type")
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+
+	tflog.Trace(ctx, "Configured ScheduleListResource")
+}
+
+func (r *ScheduleListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Trace(ctx, "Listing the ScheduleListResource")
+
+	stream.Results = listResults(ctx, r.clientConfiguration, req, NewScheduleResource, "/v1/schedules", nil, "schedule", func(schedule dto.Schedule) (string, map[string]string, bool) {
+		return schedule.Name, map[string]string{"id": schedule.Id}, true
+	})
+}
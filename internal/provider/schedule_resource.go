@@ -13,6 +13,7 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -20,6 +21,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ScheduleResource{}
 var _ resource.ResourceWithImportState = &ScheduleResource{}
+var _ resource.ResourceWithIdentity = &ScheduleResource{}
+var _ resource.ResourceWithUpgradeState = &ScheduleResource{}
 
 func NewScheduleResource() resource.Resource {
 	return &ScheduleResource{}
@@ -34,12 +37,26 @@ func (r *ScheduleResource) Metadata(_ context.Context, req resource.MetadataRequ
 	resp.TypeName = req.ProviderTypeName + "_schedule"
 }
 
-func (r *ScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ScheduleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:    1,
 		Attributes: schemaAttributes.ScheduleResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
+func (r *ScheduleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.ScheduleResourceIdentityAttributes,
+	}
+}
+
+func (r *ScheduleResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r, addDeletionProtection)
+}
+
 func (r *ScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring ScheduleResource")
 
@@ -67,14 +84,17 @@ func (r *ScheduleResource) Configure(ctx context.Context, req resource.Configure
 func (r *ScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Trace(ctx, "Creating the ScheduleResource")
 
-	var data dataModels.ScheduleModel
+	var data dataModels.ScheduleResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-	scheduleDto := ScheduleModelToDto(data)
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	scheduleDto := ScheduleModelToDto(data.ScheduleModel)
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl("v1/schedules").
 		Method(httpClient.POST).
 		SetBody(scheduleDto).
@@ -109,33 +129,38 @@ func (r *ScheduleResource) Create(ctx context.Context, req resource.CreateReques
 		)
 
 		tflog.Trace(ctx, "Deleting dangling Schedule resource")
-		cleanupScheduleSilent(r, scheduleDto)
+		cleanupScheduleSilent(ctx, r, scheduleDto)
 	}
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	data = ScheduleDtoToModel(scheduleDto)
+	data.ScheduleModel = ScheduleDtoToModel(scheduleDto)
 
 	tflog.Trace(ctx, "Created the ScheduleResource")
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 	tflog.Trace(ctx, "Saved the ScheduleResource into Terraform state")
 }
 
 func (r *ScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data dataModels.ScheduleModel
+	var data dataModels.ScheduleResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
 
 	tflog.Trace(ctx, "Reading the ScheduleResource")
 
 	scheduleDto := dto.Schedule{}
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("v1/schedules/%s", data.Id.ValueString())).
 		Method(httpClient.GET).
 		SetBodyParseObject(&scheduleDto).
@@ -168,7 +193,8 @@ func (r *ScheduleResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	data = ScheduleDtoToModel(scheduleDto)
+	data.ScheduleModel = ScheduleDtoToModel(scheduleDto)
+	data.DeletionProtection = deletionProtectionOrDefault(data.DeletionProtection)
 
 	tflog.Trace(ctx, "Read the ScheduleResource")
 
@@ -177,17 +203,20 @@ func (r *ScheduleResource) Read(ctx context.Context, req resource.ReadRequest, r
 }
 
 func (r *ScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data dataModels.ScheduleModel
+	var data dataModels.ScheduleResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Trace(ctx, "Updating the ScheduleResource")
 
-	scheduleDto := ScheduleModelToDto(data)
+	scheduleDto := ScheduleModelToDto(data.ScheduleModel)
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("v1/schedules/%s", data.Id.ValueString())).
 		Method(httpClient.PATCH).
 		SetBody(scheduleDto).
@@ -226,24 +255,33 @@ func (r *ScheduleResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	data = ScheduleDtoToModel(scheduleDto)
+	data.ScheduleModel = ScheduleDtoToModel(scheduleDto)
 
 	tflog.Trace(ctx, "Updated the ScheduleResource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 	tflog.Trace(ctx, "Saved the ScheduleResource into Terraform state")
 }
 
 func (r *ScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data dataModels.ScheduleModel
+	var data dataModels.ScheduleResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	checkDeletionProtection(data.DeletionProtection, "schedule", data.Id.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Trace(ctx, "Deleting the ScheduleResource")
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("v1/schedules/%s", data.Id.ValueString())).
 		Method(httpClient.DELETE).
 		Send()
@@ -274,12 +312,20 @@ func (r *ScheduleResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *ScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		return
+	}
+
+	importNamedResource(ctx, req, resp, "schedule", func(name string) (string, error) {
+		return resolveScheduleId(ctx, r.clientConfiguration, name)
+	})
 }
 
-func cleanupScheduleSilent(r *ScheduleResource, data dto.Schedule) {
+func cleanupScheduleSilent(ctx context.Context, r *ScheduleResource, data dto.Schedule) {
+	// The schedule is removed even if the create operation timed out
 	_, _ = httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(context.WithoutCancel(ctx), r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("v1/schedules/%s", data.Id)).
 		Method(httpClient.DELETE).
 		Send()
@@ -3,9 +3,15 @@ package provider
 import (
 	"github.com/google/uuid"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccScheduleResource_Full(t *testing.T) {
@@ -206,3 +212,141 @@ resource "atlassian-operations_schedule" "example" {
 		},
 	})
 }
+
+func TestAccScheduleResource_List(t *testing.T) {
+	scheduleName := uuid.NewString()
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_schedule" "example" {
+  name    = "` + scheduleName + `"
+  team_id = atlassian-operations_team.example.id
+  timezone = "Europe/Istanbul"
+}
+`,
+			},
+			// Import by resource identity testing
+			{
+				ResourceName:    "atlassian-operations_schedule.example",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			// List testing
+			{
+				Query: true,
+				Config: providerConfig + `
+list "atlassian-operations_schedule" "test" {
+  provider         = atlassian-operations
+  include_resource = true
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast("atlassian-operations_schedule.test", 1),
+					querycheck.ExpectResourceKnownValues("atlassian-operations_schedule.test", queryfilter.ByDisplayName(knownvalue.StringExact(scheduleName)), []querycheck.KnownValueCheck{
+						{
+							Path:       tfjsonpath.New("timezone"),
+							KnownValue: knownvalue.StringExact("Europe/Istanbul"),
+						},
+					}),
+				},
+			},
+		},
+	})
+}
+
+func TestAccScheduleResource_DeletionProtection(t *testing.T) {
+	scheduleName := uuid.NewString()
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	teamConfig := providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+`
+	scheduleConfig := func(deletionProtection string) string {
+		return teamConfig + `
+resource "atlassian-operations_schedule" "example" {
+  name    = "` + scheduleName + `"
+  team_id = atlassian-operations_team.example.id
+  timezone = "Europe/Istanbul"
+  deletion_protection = ` + deletionProtection + `
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: scheduleConfig("true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_schedule.example", "deletion_protection", "true"),
+				),
+			},
+			// Removing the protected schedule from the configuration must not destroy it
+			{
+				Config:      teamConfig,
+				ExpectError: regexp.MustCompile(`Deletion Protection Enabled`),
+			},
+			// Disabling the protection allows destroying the schedule
+			{
+				Config: scheduleConfig("false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_schedule.example", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
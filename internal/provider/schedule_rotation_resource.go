@@ -14,6 +14,7 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"slices"
@@ -24,6 +25,9 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ScheduleRotationResource{}
 var _ resource.ResourceWithImportState = &ScheduleRotationResource{}
+var _ resource.ResourceWithIdentity = &ScheduleRotationResource{}
+var _ resource.ResourceWithUpgradeState = &ScheduleRotationResource{}
+var _ resource.ResourceWithValidateConfig = &ScheduleRotationResource{}
 
 func NewScheduleRotationResource() resource.Resource {
 	return &ScheduleRotationResource{}
@@ -38,12 +42,26 @@ func (r *ScheduleRotationResource) Metadata(_ context.Context, req resource.Meta
 	resp.TypeName = req.ProviderTypeName + "_schedule_rotation"
 }
 
-func (r *ScheduleRotationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ScheduleRotationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:    0,
 		Attributes: schemaAttributes.RotationResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
+func (r *ScheduleRotationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.RotationResourceIdentityAttributes,
+	}
+}
+
+func (r *ScheduleRotationResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r)
+}
+
 func (r *ScheduleRotationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring ScheduleRotationResource")
 
@@ -68,6 +86,34 @@ func (r *ScheduleRotationResource) Configure(ctx context.Context, req resource.C
 	tflog.Trace(ctx, "Configured ScheduleRotationResource")
 }
 
+func (r *ScheduleRotationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data dataModels.RotationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateTimeRestriction(ctx, data.TimeRestriction, path.Root("time_restriction"), &resp.Diagnostics)
+
+	if data.StartDate.IsUnknown() || data.StartDate.IsNull() || data.EndDate.IsUnknown() || data.EndDate.IsNull() {
+		return
+	}
+	startDate, diags := data.StartDate.ValueRFC3339Time()
+	resp.Diagnostics.Append(diags...)
+	endDate, diags := data.EndDate.ValueRFC3339Time()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !endDate.After(startDate) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_date"),
+			"Invalid Rotation Period",
+			"end_date must be after start_date.",
+		)
+	}
+}
+
 func (r *ScheduleRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Trace(ctx, "Creating the ScheduleRotationResource")
 
@@ -75,12 +121,16 @@ func (r *ScheduleRotationResource) Create(ctx context.Context, req resource.Crea
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// We need to compare the participant lists of the initial config vs. the server response
 	plannedDto := RotationModelToDto(ctx, data)
 	rotationDto := RotationModelToDto(ctx, data)
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("v1/schedules/%s/rotations", data.ScheduleId.ValueString())).
 		Method(httpClient.POST).
 		SetBody(rotationDto).
@@ -122,7 +172,7 @@ func (r *ScheduleRotationResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	if resp.Diagnostics.HasError() {
-		cleanupRotationSilent(r, data.ScheduleId.ValueString(), rotationDto.Id)
+		cleanupRotationSilent(ctx, r, data.ScheduleId.ValueString(), rotationDto.Id)
 		return
 	}
 
@@ -147,7 +197,9 @@ func (r *ScheduleRotationResource) Create(ctx context.Context, req resource.Crea
 	tflog.Trace(ctx, "Created the ScheduleRotationResource")
 
 	// Save data into Terraform state
+	data.Timeouts = timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 	tflog.Trace(ctx, "Saved the ScheduleRotationResource into Terraform state")
 }
 
@@ -155,13 +207,18 @@ func (r *ScheduleRotationResource) Read(ctx context.Context, req resource.ReadRe
 	var data dataModels.RotationModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
+
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Read, &resp.Diagnostics)
+	defer cancel()
 
 	tflog.Trace(ctx, "Reading the ScheduleRotationResource")
 
 	rotationDto := dto.Rotation{}
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("v1/schedules/%s/rotations/%s", data.ScheduleId.ValueString(), data.Id.ValueString())).
 		Method(httpClient.GET).
 		SetBodyParseObject(&rotationDto).
@@ -214,6 +271,7 @@ func (r *ScheduleRotationResource) Read(ctx context.Context, req resource.ReadRe
 
 	tflog.Trace(ctx, "Read the ScheduleRotationResource")
 
+	data.Timeouts = timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	tflog.Trace(ctx, "Saved the ScheduleRotationResource into Terraform state")
 }
@@ -228,6 +286,10 @@ func (r *ScheduleRotationResource) Update(ctx context.Context, req resource.Upda
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &existingData)...)
 
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Trace(ctx, "Updating the ScheduleRotationResource")
 
 	plannedDto := RotationModelToDto(ctx, data)
@@ -235,7 +297,7 @@ func (r *ScheduleRotationResource) Update(ctx context.Context, req resource.Upda
 	existingRotationDto := RotationModelToDto(ctx, existingData)
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("v1/schedules/%s/rotations/%s", data.ScheduleId.ValueString(), data.Id.ValueString())).
 		Method(httpClient.PATCH).
 		SetBody(plannedDto).
@@ -274,7 +336,7 @@ func (r *ScheduleRotationResource) Update(ctx context.Context, req resource.Upda
 					"Please consider checking the ID values you specified.", plannedParticipants, newParticipants,
 			),
 		)
-		restoreRotationSlient(r, data.ScheduleId.ValueString(), existingRotationDto)
+		restoreRotationSlient(ctx, r, data.ScheduleId.ValueString(), existingRotationDto)
 	}
 
 	if resp.Diagnostics.HasError() {
@@ -301,7 +363,9 @@ func (r *ScheduleRotationResource) Update(ctx context.Context, req resource.Upda
 
 	tflog.Trace(ctx, "Updated the ScheduleRotationResource")
 
+	data.Timeouts = timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 	tflog.Trace(ctx, "Saved the ScheduleRotationResource into Terraform state")
 }
 
@@ -311,10 +375,13 @@ func (r *ScheduleRotationResource) Delete(ctx context.Context, req resource.Dele
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Trace(ctx, "Deleting the ScheduleRotationResource")
 
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("v1/schedules/%s/rotations/%s", data.ScheduleId.ValueString(), data.Id.ValueString())).
 		Method(httpClient.DELETE).
 		Send()
@@ -346,11 +413,34 @@ func (r *ScheduleRotationResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *ScheduleRotationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		return
+	}
+
+	if names, ok := parseImportReference(req.ID, "schedule", "rotation"); ok {
+		scheduleId, err := resolveScheduleId(ctx, r.clientConfiguration, names[0])
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Resolve Import Identifier", err.Error())
+			return
+		}
+		id, err := findIdByName(ctx, r.clientConfiguration, fmt.Sprintf("v1/schedules/%s/rotations", scheduleId), nil, "rotation", names[1], func(rotation dto.Rotation) (string, string) {
+			return rotation.Name, rotation.Id
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Resolve Import Identifier", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("schedule_id"), scheduleId)...)
+		return
+	}
+
 	idParts := strings.Split(req.ID, ",")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: id,schedule_id. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: id,schedule_id or schedule:<schedule name>/rotation:<name>. Got: %q", req.ID),
 		)
 		return
 	}
@@ -373,17 +463,17 @@ func areUserListsEqual(givenUserList []dto.ResponderInfo, receivedUserList []dto
 	return true
 }
 
-func cleanupRotationSilent(r *ScheduleRotationResource, scheduleID string, rotationID string) {
+func cleanupRotationSilent(ctx context.Context, r *ScheduleRotationResource, scheduleID string, rotationID string) {
 	_, _ = httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(context.WithoutCancel(ctx), r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("v1/schedules/%s/rotations/%s", scheduleID, rotationID)).
 		Method(httpClient.DELETE).
 		Send()
 }
 
-func restoreRotationSlient(r *ScheduleRotationResource, scheduleID string, rotationDto dto.Rotation) {
+func restoreRotationSlient(ctx context.Context, r *ScheduleRotationResource, scheduleID string, rotationDto dto.Rotation) {
 	_, _ = httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(context.WithoutCancel(ctx), r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("v1/schedules/%s/rotations/%s", scheduleID, rotationDto.Id)).
 		Method(httpClient.PATCH).
 		SetBody(rotationDto).
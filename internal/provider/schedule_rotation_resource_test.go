@@ -4,6 +4,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -107,6 +108,13 @@ resource "atlassian-operations_schedule_rotation" "example" {
 						nil
 				},
 			},
+			// ImportState testing by name
+			{
+				ResourceName:      "atlassian-operations_schedule_rotation.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "schedule:" + scheduleName + "/rotation:" + rotationName,
+			},
 			// Update and Read testing
 			{
 				Config: providerConfig + `
@@ -489,3 +497,45 @@ resource "atlassian-operations_schedule_rotation" "example" {
 		},
 	})
 }
+
+func TestAccScheduleRotationResource_InvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_schedule_rotation" "example" {
+  schedule_id = "00000000-0000-0000-0000-000000000000"
+  start_date  = "2023-11-11T05:00:00Z"
+  end_date    = "2023-11-10T05:00:00Z"
+  type        = "weekly"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Rotation Period"),
+			},
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_schedule_rotation" "example" {
+  schedule_id = "00000000-0000-0000-0000-000000000000"
+  start_date  = "2023-11-10T05:00:00Z"
+  type        = "weekly"
+  time_restriction = {
+	type = "time-of-day"
+	restrictions = [{
+	  start_day  = "monday"
+	  end_day    = "friday"
+	  start_hour = 9
+	  end_hour   = 17
+	  start_min  = 0
+	  end_min    = 0
+	}]
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Time Restriction"),
+			},
+		},
+	})
+}
@@ -1,7 +1,9 @@
 package schemaAttributes
 
 import (
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes/customValidators"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -63,6 +65,9 @@ var AlertPolicyResourceAttributes = map[string]schema.Attribute{
 				Required:    true,
 				Description: "List of filter conditions",
 				NestedObject: schema.NestedAttributeObject{
+					Validators: []validator.Object{
+						customValidators.ConditionFieldOperation(),
+					},
 					Attributes: map[string]schema.Attribute{
 						"field": schema.StringAttribute{
 							Required:    true,
@@ -79,6 +84,9 @@ var AlertPolicyResourceAttributes = map[string]schema.Attribute{
 						"operation": schema.StringAttribute{
 							Required:    true,
 							Description: "The operation to perform",
+							Validators: []validator.String{
+								stringvalidator.OneOf("matches", "contains", "starts-with", "ends-with", "equals", "contains-key", "contains-value", "greater-than", "less-than", "is-empty", "equals-ignore-whitespace"),
+							},
 						},
 						"expected_value": schema.StringAttribute{
 							Required:    true,
@@ -228,3 +236,14 @@ var AlertPolicyResourceAttributes = map[string]schema.Attribute{
 		Description: "Whether to keep the original tags",
 	},
 }
+
+var AlertPolicyResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the alert policy.",
+		RequiredForImport: true,
+	},
+	"team_id": identityschema.StringAttribute{
+		Description:       "The ID of the team that owns the alert policy. Not set for global policies.",
+		OptionalForImport: true,
+	},
+}
@@ -1,8 +1,9 @@
 package schemaAttributes
 
 import (
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/customTypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -27,7 +28,7 @@ var ApiIntegrationResourceAttributes = map[string]schema.Attribute{
 		},
 	},
 	"api_key": schema.StringAttribute{
-		Description: "The API key for the integration. Only available after the integration is created and cannot be fetch later. This key is used for authentication and should be kept secret.",
+		Description: "The API key for the integration. Only available after the integration is created and cannot be fetch later. This key is used for authentication and should be kept secret. Change `rotate_key_trigger` to generate a new key.",
 		PlanModifiers: []planmodifier.String{
 			stringplanmodifier.UseStateForUnknown(),
 		},
@@ -37,8 +38,11 @@ var ApiIntegrationResourceAttributes = map[string]schema.Attribute{
 		Sensitive: true,
 	},
 	"type": schema.StringAttribute{
-		Description: "The type of API integration.",
+		Description: "The type of API integration. Changing it forces a new resource.",
 		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
 	},
 	"enabled": schema.BoolAttribute{
 		Description: "Whether the API integration is enabled. When disabled, the integration will not process any requests. Defaults to false.",
@@ -47,9 +51,13 @@ var ApiIntegrationResourceAttributes = map[string]schema.Attribute{
 		Default:     booldefault.StaticBool(false),
 	},
 	"team_id": schema.StringAttribute{
-		Description: "The ID of the team that owns this API integration. Cannot be changed after creation.",
+		Description: "The ID of the team that owns this API integration. Cannot be changed after creation, changing it forces a new resource.",
 		Optional:    true,
 		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplaceIfConfigured(),
+		},
 	},
 	"advanced": schema.BoolAttribute{
 		Description: "Indicates whether this is an advanced API integration with additional configuration options.",
@@ -79,10 +87,14 @@ var ApiIntegrationResourceAttributes = map[string]schema.Attribute{
 	},
 	"type_specific_properties": schema.StringAttribute{
 		Description: "JSON object containing integration-specific configuration properties. The schema depends on the integration type.",
-		CustomType:  jsontypes.ExactType{},
+		CustomType:  customTypes.JsonWithDefaultsType{},
 		Computed:    true,
 		Optional:    true,
 	},
+	"rotate_key_trigger": schema.StringAttribute{
+		Description: "Arbitrary value that, when changed, resets the API key of the integration during the next apply and refreshes `api_key`. The integration, its actions and its routing are kept intact.",
+		Optional:    true,
+	},
 	"delete_default_actions": schema.BoolAttribute{
 		Description: "Set to true to remove default actions for this API integration. This is useful for custom integrations where default actions are not applicable. Defaults to false.",
 		Optional:    true,
@@ -90,6 +102,7 @@ var ApiIntegrationResourceAttributes = map[string]schema.Attribute{
 		Computed:    true,
 		Default:     booldefault.StaticBool(false),
 	},
+	"deletion_protection": DeletionProtectionAttribute,
 }
 
 var ApiIntegrationResourceMaintenanceSourceAttributes = map[string]schema.Attribute{
@@ -123,3 +136,10 @@ var ApiIntegrationResourceMaintenanceSourceIntervalAttributes = map[string]schem
 		Optional:    false,
 	},
 }
+
+var ApiIntegrationResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the API integration.",
+		RequiredForImport: true,
+	},
+}
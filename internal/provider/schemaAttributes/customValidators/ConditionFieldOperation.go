@@ -0,0 +1,130 @@
+package customValidators
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"slices"
+	"strings"
+)
+
+var _ validator.Object = &conditionFieldOperationValidator{}
+
+var (
+	conditionStringOperations = []string{"matches", "contains", "starts-with", "ends-with", "equals", "equals-ignore-whitespace", "is-empty"}
+	conditionListOperations   = []string{"contains", "is-empty"}
+	conditionMapOperations    = []string{"contains-key", "contains-value", "matches", "contains", "starts-with", "ends-with", "equals", "equals-ignore-whitespace", "is-empty"}
+
+	// conditionFieldOperations lists the operations JSM Operations accepts for each well-known condition field.
+	// Fields that are not listed here (e.g. integration specific fields) are only checked against the operation rules.
+	conditionFieldOperations = map[string][]string{
+		"message":          conditionStringOperations,
+		"alias":            conditionStringOperations,
+		"description":      conditionStringOperations,
+		"source":           conditionStringOperations,
+		"entity":           conditionStringOperations,
+		"tags":             conditionListOperations,
+		"actions":          conditionListOperations,
+		"responders":       conditionListOperations,
+		"priority":         {"equals", "greater-than", "less-than"},
+		"extra-properties": conditionMapOperations,
+		"details":          conditionMapOperations,
+	}
+
+	conditionKeyedFields = []string{"extra-properties", "details"}
+	conditionPriorities  = []string{"P1", "P2", "P3", "P4", "P5"}
+)
+
+type conditionFieldOperationValidator struct{}
+
+func (s conditionFieldOperationValidator) ValidateObject(_ context.Context, request validator.ObjectRequest, response *validator.ObjectResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attributes := request.ConfigValue.Attributes()
+
+	field, fieldKnown := conditionStringAttribute(attributes, "field")
+	operation, operationKnown := conditionStringAttribute(attributes, "operation")
+	expectedValue, expectedValueKnown := conditionStringAttribute(attributes, "expected_value")
+	key, keyKnown := conditionStringAttribute(attributes, "key")
+
+	if !fieldKnown || !operationKnown {
+		return
+	}
+
+	if operations, ok := conditionFieldOperations[field]; ok && !slices.Contains(operations, operation) {
+		response.Diagnostics.AddAttributeError(
+			request.Path.AtName("operation"),
+			"Invalid Condition Operation",
+			fmt.Sprintf("The operation '%s' is not supported for the field '%s'. Supported operations are: %s.", operation, field, strings.Join(operations, ", ")),
+		)
+		return
+	}
+
+	if keyKnown && key != "" && conditionFieldOperations[field] != nil && !slices.Contains(conditionKeyedFields, field) {
+		response.Diagnostics.AddAttributeError(
+			request.Path.AtName("key"),
+			"Invalid Condition Key",
+			fmt.Sprintf("The key attribute can only be used with the fields: %s. Got field '%s'.", strings.Join(conditionKeyedFields, ", "), field),
+		)
+	}
+
+	switch operation {
+	case "is-empty":
+		if expectedValueKnown && expectedValue != "" {
+			response.Diagnostics.AddAttributeError(
+				request.Path.AtName("expected_value"),
+				"Invalid Condition Expected Value",
+				"The expected_value attribute must be empty when the operation is 'is-empty'.",
+			)
+		}
+	case "contains-key":
+		// The key to look for may be given either as key or as expected_value
+		if keyKnown && key == "" && expectedValueKnown && expectedValue == "" {
+			response.Diagnostics.AddAttributeError(
+				request.Path.AtName("key"),
+				"Missing Condition Key",
+				"Either the key or the expected_value attribute must be set when the operation is 'contains-key'.",
+			)
+		}
+	default:
+		if expectedValueKnown && expectedValue == "" {
+			response.Diagnostics.AddAttributeError(
+				request.Path.AtName("expected_value"),
+				"Missing Condition Expected Value",
+				fmt.Sprintf("The expected_value attribute must be set when the operation is '%s'.", operation),
+			)
+		} else if field == "priority" && expectedValueKnown && !slices.Contains(conditionPriorities, expectedValue) {
+			response.Diagnostics.AddAttributeError(
+				request.Path.AtName("expected_value"),
+				"Invalid Condition Expected Value",
+				fmt.Sprintf("The expected_value of a priority condition must be one of: %s. Got '%s'.", strings.Join(conditionPriorities, ", "), expectedValue),
+			)
+		}
+	}
+}
+
+// conditionStringAttribute returns the value of the given string attribute and whether it is known.
+// Null values are reported as known empty strings.
+func conditionStringAttribute(attributes map[string]attr.Value, name string) (string, bool) {
+	value, ok := attributes[name].(types.String)
+	if !ok || value.IsUnknown() {
+		return "", false
+	}
+	return value.ValueString(), true
+}
+
+func (s conditionFieldOperationValidator) Description(_ context.Context) string {
+	return "The condition operation must be supported by its field, and expected_value and key must be consistent with the operation"
+}
+
+func (s conditionFieldOperationValidator) MarkdownDescription(ctx context.Context) string {
+	return s.Description(ctx)
+}
+
+func ConditionFieldOperation() validator.Object {
+	return &conditionFieldOperationValidator{}
+}
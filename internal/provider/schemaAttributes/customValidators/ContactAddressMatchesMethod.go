@@ -0,0 +1,89 @@
+package customValidators
+
+import (
+	"context"
+	"fmt"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/customTypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/mail"
+	"strings"
+)
+
+var _ validator.String = &contactAddressMatchesMethodValidator{}
+
+type contactAddressMatchesMethodValidator struct {
+	methodField path.Expression
+}
+
+func (s contactAddressMatchesMethodValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	methodFieldExpressions := request.PathExpression.MergeExpressions(s.methodField)
+
+	var methodValue types.String
+	for _, expression := range methodFieldExpressions {
+		matchedPaths, diags := request.Config.PathMatches(ctx, expression)
+		response.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			continue
+		}
+
+		for _, matchedPath := range matchedPaths {
+			diags := request.Config.GetAttribute(ctx, matchedPath, &methodValue)
+			response.Diagnostics.Append(diags...)
+		}
+	}
+
+	if methodValue.IsNull() || methodValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+	switch methodValue.ValueString() {
+	case "sms", "voice":
+		phoneNumber, ok := customTypes.NormalizePhoneNumber(value)
+		// E.164 numbers have at most 15 digits and country codes never start with 0
+		if !ok || len(phoneNumber) < 8 || len(phoneNumber) > 16 || strings.HasPrefix(phoneNumber, "+0") {
+			response.Diagnostics.AddAttributeError(
+				request.Path,
+				"Invalid Phone Number",
+				fmt.Sprintf("The %s contact %q is not a valid E.164 phone number, e.g. '+15550100000'.", methodValue.ValueString(), value),
+			)
+		}
+	case "email":
+		address, err := mail.ParseAddress(value)
+		if err != nil || address.Address != value {
+			response.Diagnostics.AddAttributeError(
+				request.Path,
+				"Invalid Email Address",
+				fmt.Sprintf("The email contact %q is not a valid email address, e.g. 'oncall@example.com'.", value),
+			)
+		}
+	case "mobile":
+		if strings.TrimSpace(value) == "" {
+			response.Diagnostics.AddAttributeError(
+				request.Path,
+				"Invalid Mobile Device",
+				"The mobile contact must be the id of a device the mobile app is registered on.",
+			)
+		}
+	}
+}
+
+func (s contactAddressMatchesMethodValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("The value must be an E.164 phone number if the field '%s' is 'sms' or 'voice', an email address if it is 'email', or a device id if it is 'mobile'", s.methodField)
+}
+
+func (s contactAddressMatchesMethodValidator) MarkdownDescription(ctx context.Context) string {
+	return s.Description(ctx)
+}
+
+func ContactAddressMatchesMethod(methodField path.Expression) validator.String {
+	return &contactAddressMatchesMethodValidator{
+		methodField: methodField,
+	}
+}
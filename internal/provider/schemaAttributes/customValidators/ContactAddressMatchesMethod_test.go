@@ -0,0 +1,72 @@
+package customValidators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestContactAddressMatchesMethod(t *testing.T) {
+	ctx := context.Background()
+
+	contactSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"method": schema.StringAttribute{Required: true},
+			"to":     schema.StringAttribute{Required: true},
+		},
+	}
+
+	tests := map[string]struct {
+		method        string
+		to            string
+		expectedError bool
+	}{
+		"e164 phone number":          {method: "sms", to: "+15550100000"},
+		"formatted phone number":     {method: "voice", to: "+1 (555) 010-0000"},
+		"api phone number":           {method: "sms", to: "1-5550100000"},
+		"phone number without plus":  {method: "voice", to: "15550100000"},
+		"phone number with letters":  {method: "sms", to: "+1 555 CALL NOW", expectedError: true},
+		"phone number too short":     {method: "sms", to: "+1555", expectedError: true},
+		"phone number too long":      {method: "voice", to: "+1555010000000000", expectedError: true},
+		"country code starting at 0": {method: "sms", to: "+05550100000", expectedError: true},
+		"email address for sms":      {method: "sms", to: "oncall@example.com", expectedError: true},
+		"email address":              {method: "email", to: "oncall@example.com"},
+		"email domain in mixed case": {method: "email", to: "oncall@Example.COM"},
+		"email address with name":    {method: "email", to: "On Call <oncall@example.com>", expectedError: true},
+		"phone number for email":     {method: "email", to: "+15550100000", expectedError: true},
+		"mobile device id":           {method: "mobile", to: "7f3c2a9e-41b8-4d55-9a4e-0c6b1f2d3e4a"},
+		"digit only mobile device":   {method: "mobile", to: "1234567890"},
+		"blank mobile device id":     {method: "mobile", to: " ", expectedError: true},
+		"unknown method":             {method: "other", to: "anything"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := tfsdk.Config{
+				Schema: contactSchema,
+				Raw: tftypes.NewValue(contactSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+					"method": tftypes.NewValue(tftypes.String, test.method),
+					"to":     tftypes.NewValue(tftypes.String, test.to),
+				}),
+			}
+			req := validator.StringRequest{
+				Path:           path.Root("to"),
+				PathExpression: path.MatchRoot("to"),
+				ConfigValue:    types.StringValue(test.to),
+				Config:         config,
+			}
+			resp := validator.StringResponse{}
+
+			ContactAddressMatchesMethod(path.MatchRelative().AtParent().AtName("method")).ValidateString(ctx, req, &resp)
+			if resp.Diagnostics.HasError() != test.expectedError {
+				t.Errorf("expected error: %t, got diagnostics: %v", test.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -1,9 +1,14 @@
 package schemaAttributes
 
 import (
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -22,12 +27,25 @@ var CustomRoleResourceAttributes = map[string]schema.Attribute{
 		Optional:    true,
 		Computed:    true,
 		ElementType: types.StringType,
-		Description: "List of permissions for the custom role. Should be alphabetical ordered.",
+		Description: "Set of rights granted to the custom role. The known rights are listed by the atlassian-operations_custom_role_rights data source.",
+		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(stringvalidator.OneOf(dto.CustomRoleRights...)),
+		},
 	},
 	"disallowed_rights": schema.SetAttribute{
 		Optional:    true,
 		Computed:    true,
 		ElementType: types.StringType,
-		Description: "List of permissions for the custom role. Should be alphabetical ordered.",
+		Description: "Set of rights disallowed for the custom role. A right can not be both granted and disallowed.",
+		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(stringvalidator.OneOf(dto.CustomRoleRights...)),
+		},
+	},
+}
+
+var CustomRoleResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the custom role.",
+		RequiredForImport: true,
 	},
 }
@@ -0,0 +1,14 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var CustomRoleRightsDataSourceAttributes = map[string]schema.Attribute{
+	"rights": schema.SetAttribute{
+		Description: "The rights that can be granted to or disallowed for a custom role",
+		Computed:    true,
+		ElementType: types.StringType,
+	},
+}
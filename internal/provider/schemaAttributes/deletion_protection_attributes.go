@@ -0,0 +1,17 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+)
+
+// DeletionProtectionAttribute is shared by the resources whose accidental removal breaks paging, such as teams,
+// schedules, escalations and API integrations.
+var DeletionProtectionAttribute = schema.BoolAttribute{
+	Description: "Whether the resource is protected from being destroyed or replaced by Terraform. While enabled, " +
+		"destroying the resource fails. Set to false and apply the configuration before destroying the resource. " +
+		"Defaults to false.",
+	Optional: true,
+	Computed: true,
+	Default:  booldefault.StaticBool(false),
+}
@@ -2,6 +2,7 @@ package schemaAttributes
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -115,3 +116,10 @@ var EmailIntegrationMaintenanceSourceMaintenanceIntervalResourceAttributes = map
 		Computed:    true,
 	},
 }
+
+var EmailIntegrationResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the email integration.",
+		RequiredForImport: true,
+	},
+}
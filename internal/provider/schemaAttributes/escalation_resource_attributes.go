@@ -4,6 +4,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
@@ -22,8 +24,11 @@ var EscalationResourceAttributes = map[string]schema.Attribute{
 		},
 	},
 	"team_id": schema.StringAttribute{
-		Description: "The ID of the team that owns this escalation policy. Used for access control and organization.",
+		Description: "The ID of the team that owns this escalation policy. Used for access control and organization. Changing it forces a new resource.",
 		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
 	},
 	"name": schema.StringAttribute{
 		Description: "The name of the escalation policy. This helps identify the policy's purpose and scope.",
@@ -57,6 +62,7 @@ var EscalationResourceAttributes = map[string]schema.Attribute{
 		Optional:    true,
 		Computed:    true,
 	},
+	"deletion_protection": DeletionProtectionAttribute,
 }
 
 var EscalationRepeatResourceAttributes = map[string]schema.Attribute{
@@ -135,3 +141,21 @@ var EscalationRuleRecipientResourceAttributes = map[string]schema.Attribute{
 		},
 	},
 }
+
+var EscalationResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the escalation policy.",
+		RequiredForImport: true,
+	},
+	"team_id": identityschema.StringAttribute{
+		Description:       "The ID of the team that owns the escalation policy.",
+		RequiredForImport: true,
+	},
+}
+
+var EscalationListResourceAttributes = map[string]listschema.Attribute{
+	"team_id": listschema.StringAttribute{
+		Description: "The ID of the team to list the escalation policies of.",
+		Required:    true,
+	},
+}
@@ -1,6 +1,7 @@
 package schemaAttributes
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -53,3 +54,14 @@ var HeartbeatResourceAttributes = map[string]schema.Attribute{
 		Optional:    true,
 	},
 }
+
+var HeartbeatResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"name": identityschema.StringAttribute{
+		Description:       "The name of the heartbeat.",
+		RequiredForImport: true,
+	},
+	"team_id": identityschema.StringAttribute{
+		Description:       "The ID of the team that owns the heartbeat.",
+		RequiredForImport: true,
+	},
+}
@@ -0,0 +1,130 @@
+package schemaAttributes
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var IncomingCallRoutingResourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The ID of the incoming call routing",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team the incoming call routing belongs to. Changing it forces a new resource.",
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the incoming call routing",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, 250),
+		},
+	},
+	"country_code": schema.StringAttribute{
+		Description: "The ISO 3166-1 alpha-2 code of the country the phone number is allocated in, e.g. 'US'. Changing it allocates a new phone number and forces a new resource.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Z]{2}$`), "must be an upper case ISO 3166-1 alpha-2 country code"),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"phone_number": schema.StringAttribute{
+		Description: "The phone number allocated for the incoming call routing",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"greeting_message": schema.StringAttribute{
+		Description: "The message read to callers before they are connected to a recipient",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, 500),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"voice": schema.SingleNestedAttribute{
+		Description: "The voice used to read the greeting message",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"language": schema.StringAttribute{
+				Description: "The language of the voice, as an IETF language tag, e.g. 'en-US'.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z]{2}-[A-Z]{2}$`), "must be a language tag such as 'en-US'"),
+				},
+			},
+			"gender": schema.StringAttribute{
+				Description: "The gender of the voice. Valid values are 'female' and 'male'.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("female", "male"),
+				},
+			},
+		},
+	},
+	"recipients": schema.ListNestedAttribute{
+		Description: "The recipients calls are routed to, in escalation order. The next recipient is tried when the previous one does not answer.",
+		Required:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Description: "The ID of the user, schedule or escalation policy.",
+					Required:    true,
+				},
+				"type": schema.StringAttribute{
+					Description: "The type of the recipient. Valid values are 'user', 'schedule' and 'escalation'.",
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("user", "schedule", "escalation"),
+					},
+				},
+			},
+		},
+	},
+	"enabled": schema.BoolAttribute{
+		Description: "Whether the incoming call routing is enabled",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(true),
+	},
+}
+
+var IncomingCallRoutingResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the incoming call routing.",
+		RequiredForImport: true,
+	},
+	"team_id": identityschema.StringAttribute{
+		Description:       "The ID of the team that owns the incoming call routing.",
+		RequiredForImport: true,
+	},
+}
@@ -1,10 +1,11 @@
 package schemaAttributes
 
 import (
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/customTypes"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes/customValidators"
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -86,6 +87,9 @@ var IntegrationActionResourceAttributes = map[string]schema.Attribute{
 				Optional:    true,
 				Computed:    true,
 				NestedObject: schema.NestedAttributeObject{
+					Validators: []validator.Object{
+						customValidators.ConditionFieldOperation(),
+					},
 					Attributes: map[string]schema.Attribute{
 						"field": schema.StringAttribute{
 							Description: "The incident field to evaluate (e.g., 'message', 'priority', 'tags').",
@@ -130,13 +134,13 @@ var IntegrationActionResourceAttributes = map[string]schema.Attribute{
 		},
 	},
 	"type_specific_properties": schema.StringAttribute{
-		CustomType:  jsontypes.ExactType{},
+		CustomType:  customTypes.JsonWithDefaultsType{},
 		Description: "Type-specific properties for the integration action",
 		Optional:    true,
 		Computed:    true,
 	},
 	"field_mappings": schema.StringAttribute{
-		CustomType:  jsontypes.ExactType{},
+		CustomType:  customTypes.JsonWithDefaultsType{},
 		Description: "Field mappings for the integration action",
 		Optional:    true,
 		Computed:    true,
@@ -151,7 +155,7 @@ var IntegrationActionResourceAttributes = map[string]schema.Attribute{
 				Required:    true,
 			},
 			"parameter": schema.StringAttribute{
-				CustomType:  jsontypes.ExactType{},
+				CustomType:  customTypes.JsonWithDefaultsType{},
 				Description: "Parameters for the action mapping",
 				Optional:    true,
 				Computed:    true,
@@ -165,3 +169,14 @@ var IntegrationActionResourceAttributes = map[string]schema.Attribute{
 		Default:     booldefault.StaticBool(true),
 	},
 }
+
+var IntegrationActionResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the integration action.",
+		RequiredForImport: true,
+	},
+	"integration_id": identityschema.StringAttribute{
+		Description:       "The ID of the integration the action belongs to.",
+		RequiredForImport: true,
+	},
+}
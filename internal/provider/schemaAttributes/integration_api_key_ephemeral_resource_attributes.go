@@ -0,0 +1,22 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var IntegrationApiKeyEphemeralResourceAttributes = map[string]schema.Attribute{
+	"integration_id": schema.StringAttribute{
+		Description: "The ID of the integration whose API key should be fetched.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"api_key": schema.StringAttribute{
+		Description: "The API key of the integration, null if the API does not expose the key of the integration. This value is never persisted to the plan or state.",
+		Computed:    true,
+		Sensitive:   true,
+	},
+}
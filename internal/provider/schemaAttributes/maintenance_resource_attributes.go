@@ -3,6 +3,7 @@ package schemaAttributes
 import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -81,3 +82,14 @@ var MaintenanceResourceAttributes = map[string]schema.Attribute{
 		},
 	},
 }
+
+var MaintenanceResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the maintenance.",
+		RequiredForImport: true,
+	},
+	"team_id": identityschema.StringAttribute{
+		Description:       "The ID of the team that owns the maintenance. Not set for global maintenances.",
+		OptionalForImport: true,
+	},
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -71,6 +72,9 @@ var NotificationPolicyResourceAttributes = map[string]schema.Attribute{
 				Required:    true,
 				Description: "List of filter conditions",
 				NestedObject: schema.NestedAttributeObject{
+					Validators: []validator.Object{
+						customValidators.ConditionFieldOperation(),
+					},
 					Attributes: map[string]schema.Attribute{
 						"field": schema.StringAttribute{
 							Required:    true,
@@ -89,6 +93,9 @@ var NotificationPolicyResourceAttributes = map[string]schema.Attribute{
 						"operation": schema.StringAttribute{
 							Required:    true,
 							Description: "The operation to perform",
+							Validators: []validator.String{
+								stringvalidator.OneOf("matches", "contains", "starts-with", "ends-with", "equals", "contains-key", "contains-value", "greater-than", "less-than", "is-empty", "equals-ignore-whitespace"),
+							},
 						},
 						"expected_value": schema.StringAttribute{
 							Required:    true,
@@ -277,3 +284,14 @@ var NotificationPolicyResourceAttributes = map[string]schema.Attribute{
 		},
 	},
 }
+
+var NotificationPolicyResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the notification policy.",
+		RequiredForImport: true,
+	},
+	"team_id": identityschema.StringAttribute{
+		Description:       "The ID of the team that owns the notification policy.",
+		RequiredForImport: true,
+	},
+}
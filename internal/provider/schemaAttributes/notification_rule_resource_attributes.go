@@ -1,9 +1,11 @@
 package schemaAttributes
 
 import (
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/customTypes"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes/customValidators"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,6 +22,16 @@ var NotificationRuleResourceAttributes = map[string]schema.Attribute{
 			stringplanmodifier.UseStateForUnknown(),
 		},
 	},
+	"user_id": schema.StringAttribute{
+		Description: "The account ID of the user the notification rule belongs to. Defaults to the user the provider authenticates as. Managing the notification rules of other users requires admin rights. Changing it forces a new resource.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
 	"name": schema.StringAttribute{
 		Description: "The name of the notification rule. This field is required and must be unique within the team.",
 		Required:    true,
@@ -52,6 +64,9 @@ var NotificationRuleResourceAttributes = map[string]schema.Attribute{
 				Description: "List of conditions that must be met for the routing rule to be applied. Required if type is 'match-all-conditions' or 'match-any-condition'.",
 				Optional:    true,
 				NestedObject: schema.NestedAttributeObject{
+					Validators: []validator.Object{
+						customValidators.ConditionFieldOperation(),
+					},
 					Attributes: map[string]schema.Attribute{
 						"field": schema.StringAttribute{
 							Description: "The incident field to evaluate (e.g., 'message', 'priority', 'tags').",
@@ -63,6 +78,9 @@ var NotificationRuleResourceAttributes = map[string]schema.Attribute{
 						"operation": schema.StringAttribute{
 							Description: "The comparison operation to perform (e.g., 'equals', 'contains', 'matches').",
 							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("matches", "contains", "starts-with", "ends-with", "equals", "contains-key", "contains-value", "greater-than", "less-than", "is-empty", "equals-ignore-whitespace"),
+							},
 						},
 						"expected_value": schema.StringAttribute{
 							Description: "The value to compare against the field value.",
@@ -135,8 +153,12 @@ var NotificationRuleResourceAttributes = map[string]schema.Attribute{
 							Required: true,
 						},
 						"to": schema.StringAttribute{
-							Description: "The recipient of the notification (e.g., email address, phone number).",
+							Description: "The recipient of the notification. An E.164 phone number for 'sms' and 'voice', an email address for 'email', or the device id for 'mobile'.",
 							Required:    true,
+							CustomType:  customTypes.ContactAddressType{},
+							Validators: []validator.String{
+								customValidators.ContactAddressMatchesMethod(path.MatchRelative().AtParent().AtName("method")),
+							},
 						},
 					},
 				},
@@ -173,3 +195,14 @@ var NotificationRuleResourceAttributes = map[string]schema.Attribute{
 		Default:     booldefault.StaticBool(true),
 	},
 }
+
+var NotificationRuleResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the notification rule.",
+		RequiredForImport: true,
+	},
+	"user_id": identityschema.StringAttribute{
+		Description:       "The account ID of the user the notification rule belongs to. Not set for rules of the user the provider authenticates as.",
+		OptionalForImport: true,
+	},
+}
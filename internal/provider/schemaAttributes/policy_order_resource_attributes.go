@@ -0,0 +1,59 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var PolicyOrderResourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The identifier of the policy order, in the form type,team_id for team policies or type for global alert policies.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team whose policies are ordered. Leave empty to order global alert policies.",
+		Optional:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"type": schema.StringAttribute{
+		Description: "The type of the ordered policies. Valid values are 'alert' and 'notification'. Notification policies always belong to a team.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("alert", "notification"),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"policy_ids": schema.ListAttribute{
+		Description: "The IDs of the policies in the order they should be evaluated. Policies that are not listed are evaluated after the listed ones.",
+		Required:    true,
+		ElementType: types.StringType,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.UniqueValues(),
+		},
+	},
+}
+
+var PolicyOrderResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"type": identityschema.StringAttribute{
+		Description:       "The type of the ordered policies.",
+		RequiredForImport: true,
+	},
+	"team_id": identityschema.StringAttribute{
+		Description:       "The ID of the team whose policies are ordered. Not set for global alert policies.",
+		OptionalForImport: true,
+	},
+}
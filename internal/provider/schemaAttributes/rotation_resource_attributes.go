@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
@@ -110,3 +111,14 @@ var ResponderInfoResourceAttributes = map[string]schema.Attribute{
 		},
 	},
 }
+
+var RotationResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the rotation.",
+		RequiredForImport: true,
+	},
+	"schedule_id": identityschema.StringAttribute{
+		Description:       "The ID of the schedule the rotation belongs to.",
+		RequiredForImport: true,
+	},
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -86,6 +87,9 @@ var RoutingRuleResourceAttributes = map[string]schema.Attribute{
 				Description: "List of conditions that must be met for the routing rule to be applied. Required if type is 'match-all-conditions' or 'match-any-condition'.",
 				Optional:    true,
 				NestedObject: schema.NestedAttributeObject{
+					Validators: []validator.Object{
+						customValidators.ConditionFieldOperation(),
+					},
 					Attributes: map[string]schema.Attribute{
 						"field": schema.StringAttribute{
 							Description: "The incident field to evaluate (e.g., 'message', 'priority', 'tags').",
@@ -97,6 +101,9 @@ var RoutingRuleResourceAttributes = map[string]schema.Attribute{
 						"operation": schema.StringAttribute{
 							Description: "The comparison operation to perform (e.g., 'equals', 'contains', 'matches').",
 							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("matches", "contains", "starts-with", "ends-with", "equals", "contains-key", "contains-value", "greater-than", "less-than", "is-empty", "equals-ignore-whitespace"),
+							},
 						},
 						"expected_value": schema.StringAttribute{
 							Description: "The value to compare against the field value.",
@@ -154,3 +161,14 @@ var RoutingRuleResourceAttributes = map[string]schema.Attribute{
 		},
 	},
 }
+
+var RoutingRuleResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the routing rule.",
+		RequiredForImport: true,
+	},
+	"team_id": identityschema.StringAttribute{
+		Description:       "The ID of the team that owns the routing rule.",
+		RequiredForImport: true,
+	},
+}
@@ -0,0 +1,82 @@
+package schemaAttributes
+
+import (
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes/customValidators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var RoutingRulesResourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The identifier of the routing rules collection. It is the same as team_id.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The unique identifier of the team whose routing rules are managed. Changing it forces a new resource.",
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"default_notify": schema.SingleNestedAttribute{
+		Description: "Who is notified by the team's default routing rule, which matches every incident no other rule matched and is always evaluated last. If not set, the default rule is left as is.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.Object{
+			customValidators.StringFieldNotNullIfOtherField(path.MatchRelative().AtName("id"), path.MatchRelative().AtName("type"), "escalation"),
+			customValidators.StringFieldNotNullIfOtherField(path.MatchRelative().AtName("id"), path.MatchRelative().AtName("type"), "schedule"),
+		},
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "The type of notification to send. Valid values are: 'none' (no notification), 'escalation' (use escalation policy), 'schedule'.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "escalation", "schedule"),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The ID of the escalation policy or schedule to notify. Required when type is 'escalation' or 'schedule'.",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	},
+	"rules": schema.ListNestedAttribute{
+		Description: "The complete, ordered list of the team's routing rules, excluding the default rule. Rules are evaluated in the given order; rules of the team that are not listed are deleted.",
+		Required:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Description: "The unique identifier of the routing rule. Rules are matched to existing ones by name.",
+					Computed:    true,
+				},
+				"name": schema.StringAttribute{
+					Description: "The name of the routing rule. It must be unique within the list, as it is used to match the rule to an existing one.",
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"timezone":         RoutingRuleResourceAttributes["timezone"],
+				"criteria":         RoutingRuleResourceAttributes["criteria"],
+				"time_restriction": RoutingRuleResourceAttributes["time_restriction"],
+				"notify":           RoutingRuleResourceAttributes["notify"],
+			},
+		},
+	},
+}
+
+var RoutingRulesResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"team_id": identityschema.StringAttribute{
+		Description:       "The ID of the team whose routing rules are managed.",
+		RequiredForImport: true,
+	},
+}
@@ -1,6 +1,7 @@
 package schemaAttributes
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -42,4 +43,12 @@ var ScheduleResourceAttributes = map[string]schema.Attribute{
 		Description: "The ID of the team that owns this schedule. Used for access control and organization of schedules.",
 		Required:    true,
 	},
+	"deletion_protection": DeletionProtectionAttribute,
+}
+
+var ScheduleResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the schedule.",
+		RequiredForImport: true,
+	},
 }
@@ -0,0 +1,153 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var ServiceDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The ID of the JSM service or Compass component to look up. Exactly one of id or name must be set.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+		},
+	},
+	"name": schema.StringAttribute{
+		Description: "The exact name of the service to look up. Exactly one of id or name must be set.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"description": schema.StringAttribute{
+		Description: "The description of the service.",
+		Computed:    true,
+	},
+	"tier": schema.Int32Attribute{
+		Description: "The tier level of the service.",
+		Computed:    true,
+	},
+	"type": schema.StringAttribute{
+		Description: "The type of the JSM service or Compass component.",
+		Computed:    true,
+	},
+	"owner": schema.StringAttribute{
+		Description: "The owner team ID of the service.",
+		Computed:    true,
+	},
+	"change_approvers": schema.SingleNestedAttribute{
+		Description: "Change approvers of the JSM service.",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"groups": schema.ListAttribute{
+				Description: "List of group IDs of the change approvers.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	},
+	"responders": schema.SingleNestedAttribute{
+		Description: "Responders of the service.",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"users": schema.ListAttribute{
+				Description: "List of user IDs of the responders.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"teams": schema.ListAttribute{
+				Description: "List of team IDs of the responders.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	},
+	"stakeholders": schema.SingleNestedAttribute{
+		Description: "Stakeholders of the JSM service.",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"users": schema.ListAttribute{
+				Description: "List of user IDs of the stakeholders.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	},
+	"projects": schema.SingleNestedAttribute{
+		Description: "Projects of the JSM service.",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"ids": schema.ListAttribute{
+				Description: "List of project IDs.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	},
+	"links": schema.ListNestedAttribute{
+		Description: "Links of the Compass component.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Description: "The type of the link.",
+					Computed:    true,
+				},
+				"url": schema.StringAttribute{
+					Description: "The URL of the link.",
+					Computed:    true,
+				},
+				"name": schema.StringAttribute{
+					Description: "The display name of the link.",
+					Computed:    true,
+				},
+			},
+		},
+	},
+}
+
+var ServicesDataSourceAttributes = map[string]schema.Attribute{
+	"owner": schema.StringAttribute{
+		Description: "Only return the services owned by this team ID.",
+		Optional:    true,
+	},
+	"tier": schema.Int32Attribute{
+		Description: "Only return the services of this tier level.",
+		Optional:    true,
+		Validators: []validator.Int32{
+			int32validator.OneOf(1, 2, 3, 4),
+		},
+	},
+	"services": schema.ListNestedAttribute{
+		Description: "The services matching the filters.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: computedServiceAttributes(),
+		},
+	},
+}
+
+// computedServiceAttributes returns the service attributes with the lookup attributes turned into read-only ones.
+func computedServiceAttributes() map[string]schema.Attribute {
+	attributes := make(map[string]schema.Attribute, len(ServiceDataSourceAttributes))
+	for name, attribute := range ServiceDataSourceAttributes {
+		attributes[name] = attribute
+	}
+	attributes["id"] = schema.StringAttribute{
+		Description: "The ID of the service.",
+		Computed:    true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: "The name of the service.",
+		Computed:    true,
+	}
+	return attributes
+}
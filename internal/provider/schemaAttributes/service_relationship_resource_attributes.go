@@ -0,0 +1,61 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var ServiceRelationshipResourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The ID of the service relationship",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"source_service_id": schema.StringAttribute{
+		Description: "The ID of the service the relationship starts from, e.g. the service that depends on the target service. Changing it forces a new resource.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"target_service_id": schema.StringAttribute{
+		Description: "The ID of the service the relationship points to, e.g. the upstream service the source service depends on. Changing it forces a new resource.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"type": schema.StringAttribute{
+		Description: "The type of the relationship. Valid values are: 'DEPENDS_ON' (the source service depends on the target service) and 'CONTAINS' (the source service contains the target service). Changing it forces a new resource.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("DEPENDS_ON", "CONTAINS"),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+}
+
+var ServiceRelationshipResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the service relationship.",
+		RequiredForImport: true,
+	},
+	"source_service_id": identityschema.StringAttribute{
+		Description:       "The ID of the service the relationship starts from.",
+		RequiredForImport: true,
+	},
+}
@@ -1,8 +1,12 @@
 package schemaAttributes
 
 import (
+	"slices"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -40,10 +44,10 @@ var ServiceResourceAttributes = map[string]schema.Attribute{
 		},
 	},
 	"type": schema.StringAttribute{
-		Description: "The type of the JSM service",
+		Description: "The type of the JSM service, one of: 'SOFTWARE_SERVICES', 'BUSINESS_SERVICES', 'CAPABILITIES_SERVICES', 'APPLICATIONS'. For Compass components, one of: 'SERVICE', 'APPLICATION', 'LIBRARY', 'CAPABILITY', 'CLOUD_RESOURCE', 'DATA_PIPELINE', 'MACHINE_LEARNING_MODEL', 'UI_ELEMENT', 'WEBSITE', 'OTHER'.",
 		Required:    true,
 		Validators: []validator.String{
-			stringvalidator.OneOf("SOFTWARE_SERVICES", "BUSINESS_SERVICES", "CAPABILITIES_SERVICES", "APPLICATIONS"),
+			stringvalidator.OneOf(append(slices.Clone(dto.JsmServiceTypes), dto.CompassComponentTypes...)...),
 		},
 	},
 	"owner": schema.StringAttribute{
@@ -54,7 +58,7 @@ var ServiceResourceAttributes = map[string]schema.Attribute{
 		},
 	},
 	"change_approvers": schema.SingleNestedAttribute{
-		Description: "Change approvers configuration for the JSM service",
+		Description: "Change approvers configuration for the JSM service. Not supported for Compass components.",
 		Optional:    true,
 		Attributes: map[string]schema.Attribute{
 			"groups": schema.ListAttribute{
@@ -81,7 +85,7 @@ var ServiceResourceAttributes = map[string]schema.Attribute{
 		},
 	},
 	"stakeholders": schema.SingleNestedAttribute{
-		Description: "Stakeholders configuration for the JSM service",
+		Description: "Stakeholders configuration for the JSM service. Not supported for Compass components.",
 		Optional:    true,
 		Attributes: map[string]schema.Attribute{
 			"users": schema.ListAttribute{
@@ -92,7 +96,7 @@ var ServiceResourceAttributes = map[string]schema.Attribute{
 		},
 	},
 	"projects": schema.SingleNestedAttribute{
-		Description: "Projects configuration for the JSM service",
+		Description: "Projects configuration for the JSM service. Not supported for Compass components.",
 		Optional:    true,
 		Attributes: map[string]schema.Attribute{
 			"ids": schema.ListAttribute{
@@ -102,4 +106,37 @@ var ServiceResourceAttributes = map[string]schema.Attribute{
 			},
 		},
 	},
+	"links": schema.ListNestedAttribute{
+		Description: "Links of the Compass component, such as its repository or dashboards. Only supported for Compass components.",
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Description: "The type of the link. Valid values are: 'DOCUMENT', 'CHAT_CHANNEL', 'REPOSITORY', 'PROJECT', 'DASHBOARD', 'ON_CALL', 'OTHER_LINK'.",
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(dto.CompassLinkTypes...),
+					},
+				},
+				"url": schema.StringAttribute{
+					Description: "The URL of the link.",
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"name": schema.StringAttribute{
+					Description: "The display name of the link.",
+					Optional:    true,
+				},
+			},
+		},
+	},
+}
+
+var ServiceResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the service.",
+		RequiredForImport: true,
+	},
 }
@@ -0,0 +1,52 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var TeamMemberRoleResourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The identifier of the role assignment, in the form team_id,account_id.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team. Changing it forces a new resource.",
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"account_id": schema.StringAttribute{
+		Description: "The account ID of the team member. The user must already be a member of the team. Changing it forces a new resource.",
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"role": schema.StringAttribute{
+		Description: "The role of the team member. Either one of the built-in roles 'admin' and 'user', or the name of a team role of the team. Destroying the resource reverts the member to the 'user' role.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+}
+
+var TeamMemberRoleResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"team_id": identityschema.StringAttribute{
+		Description:       "The ID of the team.",
+		RequiredForImport: true,
+	},
+	"account_id": identityschema.StringAttribute{
+		Description:       "The account ID of the team member.",
+		RequiredForImport: true,
+	},
+}
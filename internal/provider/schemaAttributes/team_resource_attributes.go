@@ -4,6 +4,8 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -69,6 +71,7 @@ var TeamResourceAttributes = map[string]schema.Attribute{
 		Computed:    true,
 		Default:     booldefault.StaticBool(false),
 	},
+	"deletion_protection": DeletionProtectionAttribute,
 }
 
 var PublicApiUserPermissionsResourceAttributes = map[string]schema.Attribute{
@@ -104,3 +107,21 @@ var TeamMemberResourceAttributes = map[string]schema.Attribute{
 		Required:    true,
 	},
 }
+
+var TeamResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the team.",
+		RequiredForImport: true,
+	},
+	"organization_id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the organization the team belongs to.",
+		RequiredForImport: true,
+	},
+}
+
+var TeamListResourceAttributes = map[string]listschema.Attribute{
+	"organization_id": listschema.StringAttribute{
+		Description: "The unique identifier of the organization to list the teams of.",
+		Required:    true,
+	},
+}
@@ -0,0 +1,67 @@
+package schemaAttributes
+
+import (
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var TeamRoleResourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The ID of the team role",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team the role belongs to. Changing it forces a new resource.",
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the team role. The names 'admin' and 'user' are reserved for the built-in team roles.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, 100),
+			stringvalidator.NoneOfCaseInsensitive("admin", "user"),
+		},
+	},
+	"granted_rights": schema.SetAttribute{
+		Description: "The rights granted to the members having the team role. The known rights are listed by the atlassian-operations_custom_role_rights data source.",
+		Required:    true,
+		ElementType: types.StringType,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			setvalidator.ValueStringsAre(stringvalidator.OneOf(dto.CustomRoleRights...)),
+		},
+	},
+	"disallowed_rights": schema.SetAttribute{
+		Description: "The rights disallowed for the members having the team role. A right can not be both granted and disallowed.",
+		Optional:    true,
+		ElementType: types.StringType,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			setvalidator.ValueStringsAre(stringvalidator.OneOf(dto.CustomRoleRights...)),
+		},
+	},
+}
+
+var TeamRoleResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the team role.",
+		RequiredForImport: true,
+	},
+	"team_id": identityschema.StringAttribute{
+		Description:       "The ID of the team the role belongs to.",
+		RequiredForImport: true,
+	},
+}
@@ -2,7 +2,11 @@ package schemaAttributes
 
 import (
 	"context"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/customTypes"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes/customValidators"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -16,6 +20,16 @@ var UserContactResourceAttributes = map[string]schema.Attribute{
 			stringplanmodifier.UseStateForUnknown(),
 		},
 	},
+	"user_id": schema.StringAttribute{
+		Description: "The account ID of the user the contact belongs to. Defaults to the user the provider authenticates as. Managing the contacts of other users requires admin rights. Changing it forces a new resource.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
 	"method": schema.StringAttribute{
 		Required: true,
 		Validators: []validator.String{
@@ -35,7 +49,11 @@ var UserContactResourceAttributes = map[string]schema.Attribute{
 	},
 	"to": schema.StringAttribute{
 		Required:    true,
-		Description: "The contact information for the user, such as an email address or phone number.",
+		CustomType:  customTypes.ContactAddressType{},
+		Description: "The contact information for the user. An E.164 phone number such as '+15550100000' for 'sms' and 'voice', an email address for 'email', or the device id for 'mobile'. Phone numbers may contain spaces, dashes and parentheses.",
+		Validators: []validator.String{
+			customValidators.ContactAddressMatchesMethod(path.MatchRelative().AtParent().AtName("method")),
+		},
 	},
 	"enabled": schema.BoolAttribute{
 		Optional:    true,
@@ -43,3 +61,14 @@ var UserContactResourceAttributes = map[string]schema.Attribute{
 		Description: "Whether this contact method is enabled for the user.",
 	},
 }
+
+var UserContactResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the contact.",
+		RequiredForImport: true,
+	},
+	"user_id": identityschema.StringAttribute{
+		Description:       "The account ID of the user the contact belongs to. Not set for contacts of the user the provider authenticates as.",
+		OptionalForImport: true,
+	},
+}
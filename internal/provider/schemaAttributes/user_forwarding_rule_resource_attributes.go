@@ -0,0 +1,52 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var UserForwardingRuleResourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The ID of the forwarding rule",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"from_user_id": schema.StringAttribute{
+		Description: "The account ID of the user whose notifications are forwarded",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"to_user_id": schema.StringAttribute{
+		Description: "The account ID of the user the notifications are forwarded to",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"start_date": schema.StringAttribute{
+		Description: "The date and time when forwarding begins, in RFC3339 format (e.g., '2024-01-01T00:00:00Z').",
+		Required:    true,
+		CustomType:  timetypes.RFC3339Type{},
+	},
+	"end_date": schema.StringAttribute{
+		Description: "The date and time when forwarding ends, in RFC3339 format. Must be after start_date.",
+		Required:    true,
+		CustomType:  timetypes.RFC3339Type{},
+	},
+}
+
+var UserForwardingRuleResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The unique identifier of the forwarding rule.",
+		RequiredForImport: true,
+	},
+}
@@ -0,0 +1,37 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+var UserQuietHoursResourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The account ID of the user the quiet hours belong to",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"enabled": schema.BoolAttribute{
+		Description: "Whether the quiet hours are in effect",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(true),
+	},
+	"time_restriction": schema.SingleNestedAttribute{
+		Description: "The time windows during which the user does not receive notifications.",
+		Required:    true,
+		Attributes:  TimeRestrictionResourceAttributes,
+	},
+}
+
+var UserQuietHoursResourceIdentityAttributes = map[string]identityschema.Attribute{
+	"id": identityschema.StringAttribute{
+		Description:       "The identifier of the quiet hours.",
+		RequiredForImport: true,
+	},
+}
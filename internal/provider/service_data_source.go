@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ServiceDataSource{}
+	_ datasource.DataSourceWithConfigure = &ServiceDataSource{}
+)
+
+func NewServiceDataSource() datasource.DataSource {
+	return &ServiceDataSource{}
+}
+
+// ServiceDataSource defines the data source implementation.
+type ServiceDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *ServiceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service"
+}
+
+func (d *ServiceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Service data source. Looks up a Jira Service Management service, or a Compass component when the provider's product_type is 'compass', by id or by name.",
+		Attributes:          schemaAttributes.ServiceDataSourceAttributes,
+	}
+}
+
+func (d *ServiceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring service_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure service_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured service_data_source")
+}
+
+func (d *ServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model dataModels.ServiceModel
+	var data dto.ServiceDto
+
+	tflog.Trace(ctx, "Reading service data source")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read service configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	label := serviceLabel(d.clientConfiguration)
+
+	if !model.Name.IsNull() {
+		services, err := listServices(ctx, d.clientConfiguration)
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. %s", err))
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+
+		matches := 0
+		for _, service := range services {
+			if service.Name == model.Name.ValueString() {
+				data = service
+				matches++
+			}
+		}
+
+		if matches == 0 {
+			tflog.Error(ctx, fmt.Sprintf("No %s found with name %s", label, model.Name.ValueString()))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("No %s found with name %s", label, model.Name.ValueString()))
+		} else if matches > 1 {
+			tflog.Error(ctx, fmt.Sprintf("Found %d %ss with name %s", matches, label, model.Name.ValueString()))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Found %d %ss with name %s, look the service up by id instead", matches, label, model.Name.ValueString()))
+		}
+	} else {
+		clientResp, err := httpClientHelpers.
+			GenerateServiceClientRequest(ctx, d.clientConfiguration).
+			Method(httpClient.GET).
+			JoinBaseUrl(fmt.Sprintf("%s/%s", serviceBaseUrl(d.clientConfiguration), model.ID.ValueString())).
+			SetBodyParseObject(&data).
+			Send()
+
+		if clientResp == nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read %s, got nil response", label))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s, got nil response", label))
+		} else if clientResp.IsError() {
+			statusCode := clientResp.GetStatusCode()
+			errorResponse := clientResp.GetErrorBody()
+			if errorResponse != nil {
+				tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read %s, status code: %d. Got response: %s", label, statusCode, *errorResponse))
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s, status code: %d. Got response: %s", label, statusCode, *errorResponse))
+			} else {
+				tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read %s, got http response: %d", label, statusCode))
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s, got http response: %d", label, statusCode))
+			}
+		} else if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read %s, got error: %s", label, err))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s, got error: %s", label, err))
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelPtr, diags := ServiceDtoToModel(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Successfully read service data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, modelPtr)...)
+}
@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ServiceRelationshipResource{}
+var _ resource.ResourceWithImportState = &ServiceRelationshipResource{}
+var _ resource.ResourceWithIdentity = &ServiceRelationshipResource{}
+var _ resource.ResourceWithValidateConfig = &ServiceRelationshipResource{}
+var _ resource.ResourceWithUpgradeState = &ServiceRelationshipResource{}
+
+func NewServiceRelationshipResource() resource.Resource {
+	return &ServiceRelationshipResource{}
+}
+
+// ServiceRelationshipResource defines the resource implementation.
+type ServiceRelationshipResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (r *ServiceRelationshipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_relationship"
+}
+
+func (r *ServiceRelationshipResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     0,
+		Description: "Manages a relationship between two services of the service registry, such as a dependency on an upstream service.",
+		Attributes:  schemaAttributes.ServiceRelationshipResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *ServiceRelationshipResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.ServiceRelationshipResourceIdentityAttributes,
+	}
+}
+
+func (r *ServiceRelationshipResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r)
+}
+
+func (r *ServiceRelationshipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring ServiceRelationshipResource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected dto.AtlassianOpsProviderModel, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+	tflog.Trace(ctx, "Configured ServiceRelationshipResource")
+}
+
+func (r *ServiceRelationshipResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data dataModels.ServiceRelationshipModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.SourceServiceId.IsUnknown() && !data.SourceServiceId.IsNull() && data.SourceServiceId.Equal(data.TargetServiceId) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target_service_id"),
+			"Invalid Service Relationship",
+			"A service can not have a relationship with itself, source_service_id and target_service_id must differ.",
+		)
+	}
+}
+
+func (r *ServiceRelationshipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "Creating ServiceRelationshipResource")
+
+	var data dataModels.ServiceRelationshipModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	// Both ends of the relationship must exist, the API reports a generic error otherwise
+	endpoints := []struct {
+		attribute path.Path
+		serviceId string
+	}{
+		{path.Root("source_service_id"), data.SourceServiceId.ValueString()},
+		{path.Root("target_service_id"), data.TargetServiceId.ValueString()},
+	}
+	for _, endpoint := range endpoints {
+		httpResp, err := httpClientHelpers.
+			GenerateServiceClientRequest(ctx, r.clientConfiguration).
+			JoinBaseUrl(fmt.Sprintf("%s/%s", serviceBaseUrl(r.clientConfiguration), endpoint.serviceId)).
+			Method(httpClient.GET).
+			Send()
+
+		if httpResp != nil && httpResp.GetStatusCode() == 404 {
+			resp.Diagnostics.AddAttributeError(
+				endpoint.attribute,
+				"Service Not Found",
+				fmt.Sprintf("The %s %s does not exist.", serviceLabel(r.clientConfiguration), endpoint.serviceId),
+			)
+			continue
+		}
+		handleHttpResponse(httpResp, err, "read "+serviceLabel(r.clientConfiguration), &resp.Diagnostics, ctx)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	relationshipDto := ServiceRelationshipModelToDto(data)
+	httpResp, err := httpClientHelpers.
+		GenerateServiceClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("%s/%s/relationships", serviceBaseUrl(r.clientConfiguration), data.SourceServiceId.ValueString())).
+		Method(httpClient.POST).
+		SetBody(relationshipDto).
+		SetBodyParseObject(&relationshipDto).
+		Send()
+
+	handleHttpResponse(httpResp, err, "create service relationship", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = ServiceRelationshipDtoToModel(data.SourceServiceId.ValueString(), relationshipDto)
+
+	tflog.Trace(ctx, "Created ServiceRelationshipResource")
+	data.Timeouts = timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *ServiceRelationshipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data dataModels.ServiceRelationshipModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Trace(ctx, "Reading ServiceRelationshipResource")
+
+	var relationshipDto dto.ServiceRelationshipDto
+	httpResp, err := httpClientHelpers.
+		GenerateServiceClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("%s/%s/relationships/%s", serviceBaseUrl(r.clientConfiguration), data.SourceServiceId.ValueString(), data.ID.ValueString())).
+		Method(httpClient.GET).
+		SetBodyParseObject(&relationshipDto).
+		Send()
+
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	handleHttpResponse(httpResp, err, "read service relationship", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = ServiceRelationshipDtoToModel(data.SourceServiceId.ValueString(), relationshipDto)
+	data.Timeouts = timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceRelationshipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every other attribute forces a new resource, only the timeouts can change in place
+	var data dataModels.ServiceRelationshipModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updated ServiceRelationshipResource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *ServiceRelationshipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data dataModels.ServiceRelationshipModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	httpResp, err := httpClientHelpers.
+		GenerateServiceClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("%s/%s/relationships/%s", serviceBaseUrl(r.clientConfiguration), data.SourceServiceId.ValueString(), data.ID.ValueString())).
+		Method(httpClient.DELETE).
+		Send()
+
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		return
+	}
+	handleHttpResponse(httpResp, err, "delete service relationship", &resp.Diagnostics, ctx)
+}
+
+func (r *ServiceRelationshipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		return
+	}
+
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: id,source_service_id. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source_service_id"), idParts[1])...)
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccServiceRelationshipResource(t *testing.T) {
+	upstreamServiceName := uuid.NewString()
+	downstreamServiceName := uuid.NewString()
+	teamName := uuid.NewString()
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	config := `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_service" "upstream" {
+  name        = "` + upstreamServiceName + `"
+  description = "Upstream service"
+  tier        = 1
+  type        = "SOFTWARE_SERVICES"
+  owner       = atlassian-operations_team.example.id
+}
+
+resource "atlassian-operations_service" "downstream" {
+  name        = "` + downstreamServiceName + `"
+  description = "Downstream service"
+  tier        = 2
+  type        = "SOFTWARE_SERVICES"
+  owner       = atlassian-operations_team.example.id
+}
+
+resource "atlassian-operations_service_relationship" "example" {
+  source_service_id = atlassian-operations_service.downstream.id
+  target_service_id = atlassian-operations_service.upstream.id
+  type              = "DEPENDS_ON"
+}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("atlassian-operations_service_relationship.example", "id"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_service_relationship.example", "source_service_id", "atlassian-operations_service.downstream", "id"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_service_relationship.example", "target_service_id", "atlassian-operations_service.upstream", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_service_relationship.example", "type", "DEPENDS_ON"),
+				),
+			},
+			// Update testing, only the timeouts can change without replacing the relationship
+			{
+				Config: providerConfig + strings.Replace(config, `type              = "DEPENDS_ON"`, `type              = "DEPENDS_ON"
+
+  timeouts {
+    create = "10m"
+  }`, 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("atlassian-operations_service_relationship.example", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_service_relationship.example", "timeouts.create", "10m"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "atlassian-operations_service_relationship.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return state.RootModule().Resources["atlassian-operations_service_relationship.example"].Primary.ID +
+							"," +
+							state.RootModule().Resources["atlassian-operations_service_relationship.example"].Primary.Attributes["source_service_id"],
+						nil
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccServiceRelationshipResource_SelfReference(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_service_relationship" "example" {
+  source_service_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  target_service_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  type              = "DEPENDS_ON"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Service Relationship"),
+			},
+		},
+	})
+}
@@ -3,21 +3,29 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &ServiceResource{}
 var _ resource.ResourceWithImportState = &ServiceResource{}
+var _ resource.ResourceWithIdentity = &ServiceResource{}
+var _ resource.ResourceWithModifyPlan = &ServiceResource{}
+var _ resource.ResourceWithUpgradeState = &ServiceResource{}
 
 func NewServiceResource() resource.Resource {
 	return &ServiceResource{}
@@ -32,12 +40,27 @@ func (r *ServiceResource) Metadata(_ context.Context, req resource.MetadataReque
 	resp.TypeName = req.ProviderTypeName + "_service"
 }
 
-func (r *ServiceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ServiceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Attributes: schemaAttributes.ServiceResourceAttributes,
+		Version:     0,
+		Description: "Manages a Jira Service Management service, or a Compass component when the provider's product_type is 'compass'. [Read more about services](https://support.atlassian.com/jira-service-management-cloud/docs/what-is-services/).",
+		Attributes:  schemaAttributes.ServiceResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
+func (r *ServiceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.ServiceResourceIdentityAttributes,
+	}
+}
+
+func (r *ServiceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r)
+}
+
 func (r *ServiceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring ServiceResource")
 
@@ -58,34 +81,52 @@ func (r *ServiceResource) Configure(ctx context.Context, req resource.ConfigureR
 	tflog.Trace(ctx, "Configured ServiceResource")
 }
 
+// ModifyPlan rejects attributes that the configured product does not support, as the API silently ignores them.
+func (r *ServiceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.clientConfiguration.GetProductType() == "" {
+		return
+	}
+
+	var data dataModels.ServiceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateServiceForProduct(data.ServiceModel, r.clientConfiguration.GetProductType())...)
+}
+
 func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Trace(ctx, "Creating ServiceResource")
 
-	var data dataModels.ServiceModel
+	var data dataModels.ServiceResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Convert to DTO
-	ServiceDto, diags := ServiceModelToDto(ctx, &data, r.clientConfiguration.GetCloudId())
+	ServiceDto, diags := ServiceModelToDto(ctx, &data.ServiceModel, r.clientConfiguration.GetCloudId())
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
 
-	// Create JSM service
+	// Create JSM service or Compass component
 	httpResp, err := httpClientHelpers.
-		GenerateServiceClientRequest(r.clientConfiguration).
-		JoinBaseUrl("/v1/services").
+		GenerateServiceClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(serviceBaseUrl(r.clientConfiguration)).
 		Method(httpClient.POST).
 		SetBody(ServiceDto).
 		SetBodyParseObject(&ServiceDto).
 		Send()
 
 	if httpResp == nil {
-		tflog.Error(ctx, "Client Error. Unable to create JSM service, got nil response")
-		resp.Diagnostics.AddError("Client Error", "Unable to create JSM service, got nil response")
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to create %s, got nil response", serviceLabel(r.clientConfiguration)))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create %s, got nil response", serviceLabel(r.clientConfiguration)))
 		return
 	}
 
@@ -93,18 +134,18 @@ func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest
 		statusCode := httpResp.GetStatusCode()
 		errorResponse := httpResp.GetErrorBody()
 		if errorResponse != nil {
-			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to create JSM service, status code: %d. Got response: %s", statusCode, *errorResponse))
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create JSM service, status code: %d. Got response: %s", statusCode, *errorResponse))
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to create %s, status code: %d. Got response: %s", serviceLabel(r.clientConfiguration), statusCode, *errorResponse))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create %s, status code: %d. Got response: %s", serviceLabel(r.clientConfiguration), statusCode, *errorResponse))
 		} else {
-			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to create JSM service, got http response: %d", statusCode))
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create JSM service, got http response: %d", statusCode))
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to create %s, got http response: %d", serviceLabel(r.clientConfiguration), statusCode))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create %s, got http response: %d", serviceLabel(r.clientConfiguration), statusCode))
 		}
 		return
 	}
 
 	if err != nil {
-		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to create JSM service, got error: %s", err))
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create JSM service, got error: %s", err))
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to create %s, got error: %s", serviceLabel(r.clientConfiguration), err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create %s, got error: %s", serviceLabel(r.clientConfiguration), err))
 		return
 	}
 
@@ -114,30 +155,35 @@ func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-	data = *modelPtr
+	data.ServiceModel = *modelPtr
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *ServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data dataModels.ServiceModel
+	var data dataModels.ServiceResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Trace(ctx, "Reading ServiceResource")
 
 	var ServiceDto dto.ServiceDto
 	httpResp, err := httpClientHelpers.
-		GenerateServiceClientRequest(r.clientConfiguration).
-		JoinBaseUrl(fmt.Sprintf("/v1/services/%s", data.ID.ValueString())).
+		GenerateServiceClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("%s/%s", serviceBaseUrl(r.clientConfiguration), data.ID.ValueString())).
 		Method(httpClient.GET).
 		SetBodyParseObject(&ServiceDto).
 		Send()
 
 	if httpResp == nil {
-		tflog.Error(ctx, "Client Error. Unable to read JSM service, got nil response")
-		resp.Diagnostics.AddError("Client Error", "Unable to read JSM service, got nil response")
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read %s, got nil response", serviceLabel(r.clientConfiguration)))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s, got nil response", serviceLabel(r.clientConfiguration)))
 		return
 	}
 
@@ -149,18 +195,18 @@ func (r *ServiceResource) Read(ctx context.Context, req resource.ReadRequest, re
 		}
 		errorResponse := httpResp.GetErrorBody()
 		if errorResponse != nil {
-			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read JSM service, status code: %d. Got response: %s", statusCode, *errorResponse))
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read JSM service, status code: %d. Got response: %s", statusCode, *errorResponse))
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read %s, status code: %d. Got response: %s", serviceLabel(r.clientConfiguration), statusCode, *errorResponse))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s, status code: %d. Got response: %s", serviceLabel(r.clientConfiguration), statusCode, *errorResponse))
 		} else {
-			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read JSM service, got http response: %d", statusCode))
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read JSM service, got http response: %d", statusCode))
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read %s, got http response: %d", serviceLabel(r.clientConfiguration), statusCode))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s, got http response: %d", serviceLabel(r.clientConfiguration), statusCode))
 		}
 		return
 	}
 
 	if err != nil {
-		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read JSM service, got error: %s", err))
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read JSM service or to parse received data, got error: %s", err))
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read %s, got error: %s", serviceLabel(r.clientConfiguration), err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s or to parse received data, got error: %s", serviceLabel(r.clientConfiguration), err))
 		return
 	}
 
@@ -169,36 +215,39 @@ func (r *ServiceResource) Read(ctx context.Context, req resource.ReadRequest, re
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-	data = *modelPtr
+	data.ServiceModel = *modelPtr
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data dataModels.ServiceModel
+	var data dataModels.ServiceResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Convert to DTO
-	ServiceDto, diags := ServiceModelToDto(ctx, &data, r.clientConfiguration.GetCloudId())
+	ServiceDto, diags := ServiceModelToDto(ctx, &data.ServiceModel, r.clientConfiguration.GetCloudId())
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
 
-	// Update JSM service
+	// Update JSM service or Compass component
 	httpResp, err := httpClientHelpers.
-		GenerateServiceClientRequest(r.clientConfiguration).
-		JoinBaseUrl(fmt.Sprintf("/v1/services/%s", data.ID.ValueString())).
+		GenerateServiceClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("%s/%s", serviceBaseUrl(r.clientConfiguration), data.ID.ValueString())).
 		Method(httpClient.PATCH).
 		SetBody(ServiceDto).
 		SetBodyParseObject(&ServiceDto).
 		Send()
 
 	if httpResp == nil {
-		tflog.Error(ctx, "Client Error. Unable to update JSM service, got nil response")
-		resp.Diagnostics.AddError("Client Error", "Unable to update JSM service, got nil response")
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to update %s, got nil response", serviceLabel(r.clientConfiguration)))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update %s, got nil response", serviceLabel(r.clientConfiguration)))
 		return
 	}
 
@@ -206,18 +255,18 @@ func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest
 		statusCode := httpResp.GetStatusCode()
 		errorResponse := httpResp.GetErrorBody()
 		if errorResponse != nil {
-			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to update JSM service, status code: %d. Got response: %s", statusCode, *errorResponse))
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update JSM service, status code: %d. Got response: %s", statusCode, *errorResponse))
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to update %s, status code: %d. Got response: %s", serviceLabel(r.clientConfiguration), statusCode, *errorResponse))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update %s, status code: %d. Got response: %s", serviceLabel(r.clientConfiguration), statusCode, *errorResponse))
 		} else {
-			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to update JSM service, got http response: %d", statusCode))
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update JSM service, got http response: %d", statusCode))
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to update %s, got http response: %d", serviceLabel(r.clientConfiguration), statusCode))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update %s, got http response: %d", serviceLabel(r.clientConfiguration), statusCode))
 		}
 		return
 	}
 
 	if err != nil {
-		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to update JSM service, got error: %s", err))
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update JSM service, got error: %s", err))
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to update %s, got error: %s", serviceLabel(r.clientConfiguration), err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update %s, got error: %s", serviceLabel(r.clientConfiguration), err))
 		return
 	}
 
@@ -226,27 +275,31 @@ func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-	data = *modelPtr
+	data.ServiceModel = *modelPtr
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *ServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data dataModels.ServiceModel
+	var data dataModels.ServiceResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Delete JSM service
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	// Delete JSM service or Compass component
 	httpResp, err := httpClientHelpers.
-		GenerateServiceClientRequest(r.clientConfiguration).
-		JoinBaseUrl(fmt.Sprintf("/v1/services/%s", data.ID.ValueString())).
+		GenerateServiceClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("%s/%s", serviceBaseUrl(r.clientConfiguration), data.ID.ValueString())).
 		Method(httpClient.DELETE).
 		Send()
 
 	if httpResp == nil {
-		tflog.Error(ctx, "Client Error. Unable to delete JSM service, got nil response")
-		resp.Diagnostics.AddError("Client Error", "Unable to delete JSM service, got nil response")
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to delete %s, got nil response", serviceLabel(r.clientConfiguration)))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete %s, got nil response", serviceLabel(r.clientConfiguration)))
 		return
 	}
 
@@ -254,30 +307,95 @@ func (r *ServiceResource) Delete(ctx context.Context, req resource.DeleteRequest
 		statusCode := httpResp.GetStatusCode()
 		errorResponse := httpResp.GetErrorBody()
 		if errorResponse != nil {
-			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to delete JSM service, status code: %d. Got response: %s", statusCode, *errorResponse))
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete JSM service, status code: %d. Got response: %s", statusCode, *errorResponse))
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to delete %s, status code: %d. Got response: %s", serviceLabel(r.clientConfiguration), statusCode, *errorResponse))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete %s, status code: %d. Got response: %s", serviceLabel(r.clientConfiguration), statusCode, *errorResponse))
 		} else {
-			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to delete JSM service, got http response: %d", statusCode))
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete JSM service, got http response: %d", statusCode))
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to delete %s, got http response: %d", serviceLabel(r.clientConfiguration), statusCode))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete %s, got http response: %d", serviceLabel(r.clientConfiguration), statusCode))
 		}
 		return
 	}
 
 	if err != nil {
-		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to delete JSM service, got error: %s", err))
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete JSM service, got error: %s", err))
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to delete %s, got error: %s", serviceLabel(r.clientConfiguration), err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete %s, got error: %s", serviceLabel(r.clientConfiguration), err))
 		return
 	}
 }
 
 func (r *ServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// For JSM service, we only need the service ID
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		return
+	}
+
 	if req.ID == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: service_id. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: service_id or service:<name>. Got: %q", req.ID),
 		)
 		return
 	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	importNamedResource(ctx, req, resp, "service", func(name string) (string, error) {
+		return resolveServiceId(ctx, r.clientConfiguration, name)
+	})
+}
+
+func serviceBaseUrl(configuration dto.AtlassianOpsProviderModel) string {
+	if configuration.GetProductType() == "compass" {
+		return "/v1/components"
+	}
+	return "/v1/services"
+}
+
+func serviceLabel(configuration dto.AtlassianOpsProviderModel) string {
+	if configuration.GetProductType() == "compass" {
+		return "Compass component"
+	}
+	return "JSM service"
+}
+
+func validateServiceForProduct(data dataModels.ServiceModel, productType string) (diags diag.Diagnostics) {
+	serviceTypes := dto.JsmServiceTypes
+	if productType == "compass" {
+		serviceTypes = dto.CompassComponentTypes
+
+		jsmOnlyAttributes := []struct {
+			name  string
+			value types.Object
+		}{
+			{"change_approvers", data.ChangeApprovers},
+			{"stakeholders", data.Stakeholders},
+			{"projects", data.Projects},
+		}
+		for _, attribute := range jsmOnlyAttributes {
+			if !attribute.value.IsNull() {
+				diags.AddAttributeError(
+					path.Root(attribute.name),
+					"Unsupported Service Attribute",
+					fmt.Sprintf("The attribute %s is not supported for Compass components.", attribute.name),
+				)
+			}
+		}
+	} else if !data.Links.IsNull() {
+		diags.AddAttributeError(
+			path.Root("links"),
+			"Unsupported Service Attribute",
+			"The attribute links is only supported for Compass components.",
+		)
+	}
+
+	if !data.Type.IsUnknown() && !slices.Contains(serviceTypes, data.Type.ValueString()) {
+		diags.AddAttributeError(
+			path.Root("type"),
+			"Invalid Service Type",
+			fmt.Sprintf("The type '%s' is not supported when product_type is '%s'. Supported types are: %s.", data.Type.ValueString(), productType, strings.Join(serviceTypes, ", ")),
+		)
+	}
+	return
+}
+
+// listServices pages through every JSM service or Compass component of the site.
+func listServices(ctx context.Context, configuration dto.AtlassianOpsProviderModel) ([]dto.ServiceDto, error) {
+	return listAllWith[dto.ServiceDto](ctx, configuration, httpClientHelpers.GenerateServiceClientRequest, serviceBaseUrl(configuration), nil, serviceLabel(configuration))
 }
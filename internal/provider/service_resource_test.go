@@ -2,6 +2,7 @@ package provider
 
 import (
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/google/uuid"
@@ -158,3 +159,30 @@ resource "atlassian-operations_service" "example" {
 		},
 	})
 }
+
+func TestAccServiceResource_CompassOnlyAttributes(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_service" "example" {
+  name        = "Compass only attributes"
+  description = "Links are only supported for Compass components"
+  tier        = 2
+  type        = "SOFTWARE_SERVICES"
+  owner       = ""
+  links = [
+    {
+      type = "REPOSITORY"
+      url  = "https://bitbucket.org/example/repository"
+    }
+  ]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Unsupported Service Attribute"),
+			},
+		},
+	})
+}
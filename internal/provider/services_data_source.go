@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ServicesDataSource{}
+	_ datasource.DataSourceWithConfigure = &ServicesDataSource{}
+)
+
+func NewServicesDataSource() datasource.DataSource {
+	return &ServicesDataSource{}
+}
+
+// ServicesDataSource defines the data source implementation.
+type ServicesDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *ServicesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_services"
+}
+
+func (d *ServicesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Services data source. Lists the Jira Service Management services, or the Compass components when the provider's product_type is 'compass', optionally filtered by owner team and tier.",
+		Attributes:          schemaAttributes.ServicesDataSourceAttributes,
+	}
+}
+
+func (d *ServicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring services_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure services_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured services_data_source")
+}
+
+func (d *ServicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model dataModels.ServicesModel
+
+	tflog.Trace(ctx, "Reading services data source")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read services configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	services, err := listServices(ctx, d.clientConfiguration)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. %s", err))
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	serviceValues := make([]attr.Value, 0, len(services))
+	for _, service := range services {
+		if !model.Owner.IsNull() && service.Owner != model.Owner.ValueString() {
+			continue
+		}
+		if !model.Tier.IsNull() && service.Tier != model.Tier.ValueInt32() {
+			continue
+		}
+
+		serviceModel, diags := ServiceDtoToModel(ctx, &service)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		serviceValues = append(serviceValues, serviceModel.AsValue())
+	}
+
+	model.Services = types.ListValueMust(types.ObjectType{AttrTypes: dataModels.ServiceModelMap}, serviceValues)
+
+	tflog.Trace(ctx, "Successfully read services data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServicesDataSource(t *testing.T) {
+	serviceName := uuid.NewString()
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_service" "example" {
+  name        = "` + serviceName + `"
+  description = "Test JSM Service Description"
+  tier        = 2
+  type        = "SOFTWARE_SERVICES"
+  owner       = atlassian-operations_team.example.id
+}
+
+data "atlassian-operations_services" "test" {
+  owner = atlassian-operations_service.example.owner
+  tier  = 2
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.atlassian-operations_services.test", "services.#", "1"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_services.test", "services.0.id", "atlassian-operations_service.example", "id"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_services.test", "services.0.name", serviceName),
+					resource.TestCheckResourceAttr("data.atlassian-operations_services.test", "services.0.type", "SOFTWARE_SERVICES"),
+				),
+			},
+		},
+	})
+}
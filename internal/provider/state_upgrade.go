@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// stateUpgradeStep migrates the JSON attributes of a resource state from one
+// schema version to the next, editing them in place.
+type stateUpgradeStep func(attributes map[string]interface{}) error
+
+// chainedStateUpgraders returns the upgraders bringing the state of every prior
+// schema version of res up to its current version. steps[i] migrates version i
+// to version i+1, a resource at schema version n therefore declares n steps.
+// When bumping a schema version, append a step and add a state fixture for
+// the new version under testdata/state_upgrades.
+func chainedStateUpgraders(ctx context.Context, res resource.Resource, steps ...stateUpgradeStep) map[int64]resource.StateUpgrader {
+	var schemaResp resource.SchemaResponse
+	res.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	currentSchema := schemaResp.Schema
+
+	upgraders := make(map[int64]resource.StateUpgrader, len(steps))
+	for version := range steps {
+		remainingSteps := steps[version:]
+		upgraders[int64(version)] = resource.StateUpgrader{
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				upgradeRawState(ctx, currentSchema, remainingSteps, req, resp)
+			},
+		}
+	}
+
+	return upgraders
+}
+
+func upgradeRawState(ctx context.Context, currentSchema schema.Schema, steps []stateUpgradeStep, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	if req.RawState == nil || req.RawState.JSON == nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", "The prior state is missing or was not written in JSON format.")
+		return
+	}
+
+	// Numbers are kept as written, float64 would lose precision on large integers
+	decoder := json.NewDecoder(bytes.NewReader(req.RawState.JSON))
+	decoder.UseNumber()
+	var attributes map[string]interface{}
+	if err := decoder.Decode(&attributes); err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Unable to parse the prior state: %s", err))
+		return
+	}
+
+	for _, step := range steps {
+		if err := step(attributes); err != nil {
+			resp.Diagnostics.AddError("Unable to Upgrade Resource State", err.Error())
+			return
+		}
+	}
+
+	upgradedJSON, err := json.Marshal(attributes)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Unable to encode the upgraded state: %s", err))
+		return
+	}
+
+	stateType := currentSchema.Type().TerraformType(ctx)
+	rawState := tfprotov6.RawState{JSON: upgradedJSON}
+	value, err := rawState.UnmarshalWithOpts(stateType, tfprotov6.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("The upgraded state does not match the current schema: %s", err))
+		return
+	}
+
+	dynamicValue, err := tfprotov6.NewDynamicValue(stateType, value)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Unable to encode the upgraded state: %s", err))
+		return
+	}
+	resp.DynamicValue = &dynamicValue
+}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const stateUpgradeFixturesDir = "testdata/state_upgrades"
+
+// TestStateUpgradeFixtures ensures every resource has a state fixture for each
+// of its schema versions, that the fixture of the current version matches the
+// schema and that every prior version is upgraded into it.
+func TestStateUpgradeFixtures(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")()
+
+	for _, newResource := range p.Resources(ctx) {
+		res := newResource()
+
+		var metadataResp resource.MetadataResponse
+		res.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "atlassian-operations"}, &metadataResp)
+		name := strings.TrimPrefix(metadataResp.TypeName, "atlassian-operations_")
+
+		t.Run(name, func(t *testing.T) {
+			var schemaResp resource.SchemaResponse
+			res.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			currentVersion := schemaResp.Schema.Version
+			stateType := schemaResp.Schema.Type().TerraformType(ctx)
+
+			upgradable, ok := res.(resource.ResourceWithUpgradeState)
+			if !ok {
+				t.Fatalf("%s does not implement resource.ResourceWithUpgradeState", metadataResp.TypeName)
+			}
+			upgraders := upgradable.UpgradeState(ctx)
+			if int64(len(upgraders)) != currentVersion {
+				t.Fatalf("expected %d state upgraders for schema version %d, got %d", currentVersion, currentVersion, len(upgraders))
+			}
+
+			currentFixture := readStateUpgradeFixture(t, name, currentVersion)
+			expected, err := (&tfprotov6.RawState{JSON: currentFixture}).Unmarshal(stateType)
+			if err != nil {
+				t.Fatalf("fixture v%d does not match the current schema: %s", currentVersion, err)
+			}
+			checkFixtureAttributes(t, currentFixture, schemaResp.Schema)
+
+			for version := int64(0); version < currentVersion; version++ {
+				actual := runStateUpgrader(t, upgraders, version, readStateUpgradeFixture(t, name, version), stateType)
+				if !actual.Equal(expected) {
+					t.Errorf("upgrading fixture v%d did not produce fixture v%d:\n%s", version, currentVersion, actual.String())
+				}
+			}
+		})
+	}
+}
+
+func TestChainedStateUpgraders(t *testing.T) {
+	ctx := context.Background()
+	res := &testStateUpgradeResource{}
+
+	var schemaResp resource.SchemaResponse
+	res.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	stateType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	expected := tftypes.NewValue(stateType, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "1"),
+		"name": tftypes.NewValue(tftypes.String, "example"),
+		"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "critical"),
+		}),
+	})
+
+	upgraders := res.UpgradeState(ctx)
+	if len(upgraders) != 2 {
+		t.Fatalf("expected 2 state upgraders, got %d", len(upgraders))
+	}
+
+	priorStates := map[int64]string{
+		0: `{"id": "1", "title": "example", "tag": "critical"}`,
+		1: `{"id": "1", "name": "example", "tag": "critical"}`,
+	}
+	for version, priorState := range priorStates {
+		actual := runStateUpgrader(t, upgraders, version, []byte(priorState), stateType)
+		if !actual.Equal(expected) {
+			t.Errorf("upgrading v%d state got %s, expected %s", version, actual.String(), expected.String())
+		}
+	}
+
+	var resp resource.UpgradeStateResponse
+	upgraders[0].StateUpgrader(ctx, resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{JSON: []byte(`{"id": "1", "tag": "critical"}`)},
+	}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Errorf("expected an error when a step fails")
+	}
+}
+
+func readStateUpgradeFixture(t *testing.T, name string, version int64) []byte {
+	t.Helper()
+
+	fixture, err := os.ReadFile(filepath.Join(stateUpgradeFixturesDir, name, fmt.Sprintf("v%d.json", version)))
+	if err != nil {
+		t.Fatalf("missing state fixture for schema version %d: %s", version, err)
+	}
+	return fixture
+}
+
+func checkFixtureAttributes(t *testing.T, fixture []byte, s schema.Schema) {
+	t.Helper()
+
+	var attributes map[string]json.RawMessage
+	if err := json.Unmarshal(fixture, &attributes); err != nil {
+		t.Fatalf("unable to parse fixture: %s", err)
+	}
+
+	var missing []string
+	for name := range s.GetAttributes() {
+		if _, ok := attributes[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	for name := range s.GetBlocks() {
+		if _, ok := attributes[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		t.Errorf("fixture of the current schema version is missing attributes: %s", strings.Join(missing, ", "))
+	}
+}
+
+func runStateUpgrader(t *testing.T, upgraders map[int64]resource.StateUpgrader, version int64, priorState []byte, stateType tftypes.Type) tftypes.Value {
+	t.Helper()
+
+	upgrader, ok := upgraders[version]
+	if !ok {
+		t.Fatalf("no state upgrader for schema version %d", version)
+	}
+
+	var resp resource.UpgradeStateResponse
+	upgrader.StateUpgrader(context.Background(), resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{JSON: priorState},
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unable to upgrade state from schema version %d: %v", version, resp.Diagnostics)
+	}
+	if resp.DynamicValue == nil {
+		t.Fatalf("state upgrader for schema version %d returned no state", version)
+	}
+
+	value, err := resp.DynamicValue.Unmarshal(stateType)
+	if err != nil {
+		t.Fatalf("unable to decode upgraded state: %s", err)
+	}
+	return value
+}
+
+// testStateUpgradeResource is a resource at schema version 2 which renamed
+// "title" to "name" in version 1 and replaced "tag" with a "tags" list in
+// version 2.
+type testStateUpgradeResource struct{}
+
+func (r *testStateUpgradeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_test"
+}
+
+func (r *testStateUpgradeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: 2,
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Computed: true},
+			"name": schema.StringAttribute{Required: true},
+			"tags": schema.ListAttribute{ElementType: types.StringType, Optional: true},
+		},
+	}
+}
+
+func (r *testStateUpgradeResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r,
+		func(attributes map[string]interface{}) error {
+			title, ok := attributes["title"]
+			if !ok {
+				return fmt.Errorf("missing title attribute")
+			}
+			attributes["name"] = title
+			delete(attributes, "title")
+			return nil
+		},
+		func(attributes map[string]interface{}) error {
+			if tag, ok := attributes["tag"]; ok && tag != nil {
+				attributes["tags"] = []interface{}{tag}
+			}
+			delete(attributes, "tag")
+			return nil
+		},
+	)
+}
+
+func (r *testStateUpgradeResource) Create(_ context.Context, _ resource.CreateRequest, _ *resource.CreateResponse) {
+}
+
+func (r *testStateUpgradeResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+}
+
+func (r *testStateUpgradeResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+func (r *testStateUpgradeResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
@@ -96,7 +96,7 @@ func (d *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	tflog.Trace(ctx, "Sending HTTP request to JSM Teams API")
 
 	clientResp, err := httpClientHelpers.
-		GenerateTeamsClientRequest(d.clientConfiguration).
+		GenerateTeamsClientRequest(ctx, d.clientConfiguration).
 		Method("GET").
 		JoinBaseUrl(teamFetchUrl).
 		SetQueryParam("siteId", model.SiteId.ValueString()).
@@ -126,7 +126,7 @@ func (d *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	tflog.Trace(ctx, "Sending HTTP request to JSM Team Members API")
 
 	clientResp, err = httpClientHelpers.
-		GenerateTeamsClientRequest(d.clientConfiguration).
+		GenerateTeamsClientRequest(ctx, d.clientConfiguration).
 		Method("POST").
 		JoinBaseUrl(teamMembersFetchUrl).
 		SetBodyParseObject(&memberData).
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &TeamListResource{}
+	_ list.ListResourceWithConfigure = &TeamListResource{}
+)
+
+func NewTeamListResource() list.ListResource {
+	return &TeamListResource{}
+}
+
+// TeamListResource defines the list resource implementation.
+type TeamListResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (r *TeamListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (r *TeamListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the teams of an organization that use Operations.",
+		Attributes:  schemaAttributes.TeamListResourceAttributes,
+	}
+}
+
+func (r *TeamListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring TeamListResource")
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Unexpected List Resource Configure Type")
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+
+	tflog.Trace(ctx, "Configured TeamListResource")
+}
+
+func (r *TeamListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Trace(ctx, "Listing the TeamListResource")
+
+	var data dataModels.TeamListModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	organizationId := data.OrganizationId.ValueString()
+	stream.Results = listResults(ctx, r.clientConfiguration, req, NewTeamResource, "/v1/teams", nil, "team", func(team dto.OpsTeamDto) (string, map[string]string, bool) {
+		return team.TeamName, map[string]string{"id": team.TeamId, "organization_id": organizationId}, true
+	})
+}
@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Role team members fall back to when their role assignment is destroyed
+const defaultTeamMemberRole = "user"
+
+var (
+	_ resource.Resource                 = &TeamMemberRoleResource{}
+	_ resource.ResourceWithConfigure    = &TeamMemberRoleResource{}
+	_ resource.ResourceWithImportState  = &TeamMemberRoleResource{}
+	_ resource.ResourceWithIdentity     = &TeamMemberRoleResource{}
+	_ resource.ResourceWithUpgradeState = &TeamMemberRoleResource{}
+)
+
+type TeamMemberRoleResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func NewTeamMemberRoleResource() resource.Resource {
+	return &TeamMemberRoleResource{}
+}
+
+func (r *TeamMemberRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_member_role"
+}
+
+func (r *TeamMemberRoleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     0,
+		Description: "Assign a role to a member of a team in Atlassian Operations. Team membership itself is managed by the atlassian-operations_team resource.",
+		Attributes:  schemaAttributes.TeamMemberRoleResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *TeamMemberRoleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.TeamMemberRoleResourceIdentityAttributes,
+	}
+}
+
+func (r *TeamMemberRoleResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r)
+}
+
+func (r *TeamMemberRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring TeamMemberRoleResource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+	tflog.Trace(ctx, "Configured TeamMemberRoleResource")
+}
+
+func (r *TeamMemberRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "Creating TeamMemberRoleResource")
+
+	var data dataModels.TeamMemberRoleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	roleDto := r.setRole(ctx, data.TeamID.ValueString(), data.AccountId.ValueString(), data.Role.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = TeamMemberRoleDtoToModel(data.TeamID.ValueString(), data.AccountId.ValueString(), roleDto)
+	data.Timeouts = timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "Created TeamMemberRoleResource")
+}
+
+func (r *TeamMemberRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "Reading TeamMemberRoleResource")
+
+	var data dataModels.TeamMemberRoleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	var roleDto dto.TeamMemberRoleDto
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/members/%s/role", data.TeamID.ValueString(), data.AccountId.ValueString())).
+		Method(httpClient.GET).
+		SetBodyParseObject(&roleDto).
+		Send()
+
+	// The user is no longer a member of the team
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+	handleHttpResponse(httpResp, err, "read team member role", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = TeamMemberRoleDtoToModel(data.TeamID.ValueString(), data.AccountId.ValueString(), &roleDto)
+	data.Timeouts = timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Read TeamMemberRoleResource")
+}
+
+func (r *TeamMemberRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "Updating TeamMemberRoleResource")
+
+	var data dataModels.TeamMemberRoleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	roleDto := r.setRole(ctx, data.TeamID.ValueString(), data.AccountId.ValueString(), data.Role.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = TeamMemberRoleDtoToModel(data.TeamID.ValueString(), data.AccountId.ValueString(), roleDto)
+	data.Timeouts = timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "Updated TeamMemberRoleResource")
+}
+
+func (r *TeamMemberRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "Deleting TeamMemberRoleResource")
+
+	var data dataModels.TeamMemberRoleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	// Members can not exist without a role, destroying the assignment reverts to the default role
+	var diags diag.Diagnostics
+	r.setRole(ctx, data.TeamID.ValueString(), data.AccountId.ValueString(), defaultTeamMemberRole, &diags)
+	for _, d := range diags {
+		// The member was already removed from the team
+		if d.Summary() == "Team Member Not Found" {
+			return
+		}
+	}
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "Deleted TeamMemberRoleResource")
+}
+
+func (r *TeamMemberRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var teamId, accountId types.String
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("team_id"), &teamId)...)
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("account_id"), &accountId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), teamId.ValueString()+","+accountId.ValueString())...)
+		return
+	}
+
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: team_id,account_id or team:<team name>,account_id. Got: %q", req.ID),
+		)
+		return
+	}
+	if names, ok := parseImportReference(idParts[0], "team"); ok {
+		teamId, err := resolveTeamId(ctx, r.clientConfiguration, names[0])
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Resolve Import Identifier", err.Error())
+			return
+		}
+		idParts[0] = teamId
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strings.Join(idParts, ","))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_id"), idParts[1])...)
+}
+
+func (r *TeamMemberRoleResource) setRole(ctx context.Context, teamId string, accountId string, role string, diags *diag.Diagnostics) *dto.TeamMemberRoleDto {
+	roleDto := dto.TeamMemberRoleDto{Role: role}
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/members/%s/role", teamId, accountId)).
+		Method(httpClient.PUT).
+		SetBody(roleDto).
+		SetBodyParseObject(&roleDto).
+		Send()
+
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		diags.AddAttributeError(
+			path.Root("account_id"),
+			"Team Member Not Found",
+			fmt.Sprintf("The user %s is not a member of the team %s.", accountId, teamId),
+		)
+		return nil
+	}
+	handleHttpResponse(httpResp, err, "update team member role", diags, ctx)
+
+	return &roleDto
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamMemberRoleResource(t *testing.T) {
+	teamName := uuid.NewString()
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+	emailSecondary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_SECONDARY")
+
+	teamMemberRoleConfig := func(role string) string {
+		return providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+data "atlassian-operations_user" "test2" {
+	email_address = "` + emailSecondary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    },
+    {
+      account_id = data.atlassian-operations_user.test2.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_team_member_role" "example" {
+  team_id    = atlassian-operations_team.example.id
+  account_id = data.atlassian-operations_user.test2.account_id
+  role       = "` + role + `"
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+			if emailSecondary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_SECONDARY must be set for acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: teamMemberRoleConfig("admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("atlassian-operations_team_member_role.example", "team_id", "atlassian-operations_team.example", "id"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_team_member_role.example", "account_id", "data.atlassian-operations_user.test2", "account_id"),
+					resource.TestCheckResourceAttr("atlassian-operations_team_member_role.example", "role", "admin"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "atlassian-operations_team_member_role.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: teamMemberRoleConfig("user"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_team_member_role.example", "role", "user"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"net/url"
@@ -22,6 +23,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &TeamResource{}
 var _ resource.ResourceWithImportState = &TeamResource{}
+var _ resource.ResourceWithIdentity = &TeamResource{}
+var _ resource.ResourceWithUpgradeState = &TeamResource{}
 
 func NewTeamResource() resource.Resource {
 	return &TeamResource{}
@@ -36,12 +39,26 @@ func (r *TeamResource) Metadata(_ context.Context, req resource.MetadataRequest,
 	resp.TypeName = req.ProviderTypeName + "_team"
 }
 
-func (r *TeamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *TeamResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:    1,
 		Attributes: schemaAttributes.TeamResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
+func (r *TeamResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.TeamResourceIdentityAttributes,
+	}
+}
+
+func (r *TeamResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r, addDeletionProtection)
+}
+
 func (r *TeamResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring TeamResource")
 
@@ -69,16 +86,19 @@ func (r *TeamResource) Configure(ctx context.Context, req resource.ConfigureRequ
 func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Trace(ctx, "Creating the TeamResource")
 
-	var data dataModels.TeamModel
+	var data dataModels.TeamResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-	teamDto, membersDto := TeamModelToDto(ctx, data)
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	teamDto, membersDto := TeamModelToDto(ctx, data.TeamModel)
 
 	tflog.Trace(ctx, "Creating the Team")
 
 	httpResp, err := httpClientHelpers.
-		GenerateTeamsClientRequest(r.clientConfiguration).
+		GenerateTeamsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("%s/teams/", teamDto.OrganizationId)).
 		Method(httpClient.POST).
 		SetBody(teamDto).
@@ -111,14 +131,14 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	tflog.Trace(ctx, "Team created")
 	tflog.Trace(ctx, "Fetch auto created members")
 
-	autoAddedMembers, err := r.fetchTeamMembers(teamDto.OrganizationId, teamDto.TeamId)
+	autoAddedMembers, err := r.fetchTeamMembers(ctx, teamDto.OrganizationId, teamDto.TeamId)
 	if err != nil {
 		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to fetch members for the created team, %s", err.Error()))
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch members for the created team, %s", err.Error()))
 	}
 	if resp.Diagnostics.HasError() {
 		tflog.Trace(ctx, "Deleting dangling team resource")
-		r.cleanupTeamSilent(teamDto)
+		r.cleanupTeamSilent(ctx, teamDto)
 		return
 	}
 
@@ -128,7 +148,7 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 		tflog.Trace(ctx, "Adding users to the team")
 		memberAddResponse := dto.PublicApiMembershipAddResponse{}
 		httpResp, err = httpClientHelpers.
-			GenerateTeamsClientRequest(r.clientConfiguration).
+			GenerateTeamsClientRequest(ctx, r.clientConfiguration).
 			JoinBaseUrl(fmt.Sprintf("%s/teams/%s/members/add", teamDto.OrganizationId, teamDto.TeamId)).
 			Method(httpClient.POST).
 			SetBody(dto.TeamMemberList{Members: addedUsers}).
@@ -161,7 +181,7 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 			// If there is an error while adding users, the creation fails on Terraform's side, even though there is still a team on JSM side.
 			// So, we need to delete the team on JSM side if the adding users fails.
 			tflog.Trace(ctx, "Deleting dangling team resource")
-			r.cleanupTeamSilent(teamDto)
+			r.cleanupTeamSilent(ctx, teamDto)
 			return
 		}
 		tflog.Trace(ctx, "Users added to the team")
@@ -171,7 +191,7 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 		tflog.Trace(ctx, "Removing extra users from the team")
 		removeMemberResponse := dto.PublicApiMembershipRemoveResponse{}
 		httpResp, err = httpClientHelpers.
-			GenerateTeamsClientRequest(r.clientConfiguration).
+			GenerateTeamsClientRequest(ctx, r.clientConfiguration).
 			JoinBaseUrl(fmt.Sprintf("%s/teams/%s/members/remove", teamDto.OrganizationId, teamDto.TeamId)).
 			Method(httpClient.POST).
 			SetBody(dto.TeamMemberList{Members: removedUsers}).
@@ -203,7 +223,7 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	if resp.Diagnostics.HasError() {
 		tflog.Trace(ctx, "Deleting dangling team resource")
-		r.cleanupTeamSilent(teamDto)
+		r.cleanupTeamSilent(ctx, teamDto)
 		return
 	}
 	tflog.Trace(ctx, "Extra users removed from the team")
@@ -218,7 +238,7 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	// Enable OPS for the Team
 	httpResp, err = httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
 		AddRetryCondition(func(response *httpClient.Response, err error) bool {
 			if response.GetStatusCode() == 404 || response.GetStatusCode() == 422 {
 				return true
@@ -253,7 +273,7 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 		// If there is an error while enabling ops, the creation fails on Terraform's side, even though there is still a team on JSM side.
 		// So, we need to delete the team on JSM side if the enabling ops fails.
 		tflog.Trace(ctx, "Deleting dangling team resource")
-		r.cleanupTeamSilent(teamDto)
+		r.cleanupTeamSilent(ctx, teamDto)
 		return
 	}
 	tflog.Trace(ctx, "Enabled Operations for the Team")
@@ -262,34 +282,35 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	if data.DeleteDefaultResources.ValueBool() {
 		tflog.Trace(ctx, "Deleting default resources for the team")
 
-		err = findAndUpdateDefaultRoutingRule(teamDto.TeamId, r.clientConfiguration)
+		err = findAndUpdateDefaultRoutingRule(ctx, teamDto.TeamId, r.clientConfiguration)
 		if err != nil {
 			tflog.Trace(ctx, "Could not find and update default routing rule for team", map[string]interface{}{"teamId": teamDto.TeamId, "error": err.Error()})
 		}
 
-		err = findAndDeleteDefaultEscalation(teamDto.TeamId, r.clientConfiguration)
+		err = findAndDeleteDefaultEscalation(ctx, teamDto.TeamId, r.clientConfiguration)
 		if err != nil {
 			tflog.Trace(ctx, "Could not find and delete default escalation for team", map[string]interface{}{"teamId": teamDto.TeamId, "error": err.Error()})
 		}
 
-		err = findAndDeleteDefaultSchedule(teamDto.TeamId, r.clientConfiguration)
+		err = findAndDeleteDefaultSchedule(ctx, teamDto.TeamId, r.clientConfiguration)
 		if err != nil {
 			tflog.Trace(ctx, "Could not find and delete default schedule for team", map[string]interface{}{"teamId": teamDto.TeamId, "error": err.Error()})
 		}
 	}
 
-	data = TeamDtoToModel(teamDto, membersDto, data.DeleteDefaultResources)
+	data.TeamModel = TeamDtoToModel(teamDto, membersDto, data.DeleteDefaultResources)
 
 	tflog.Trace(ctx, "Created the TeamResource")
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 	tflog.Trace(ctx, "Saved the TeamResource into Terraform state")
 }
 
 // list schedules using teamId then delete its default schedule
-func findAndDeleteDefaultSchedule(teamId string, configuration dto.AtlassianOpsProviderModel) error {
-	tflog.Trace(context.Background(), "Finding and deleting default schedule for team", map[string]interface{}{"teamId": teamId})
+func findAndDeleteDefaultSchedule(ctx context.Context, teamId string, configuration dto.AtlassianOpsProviderModel) error {
+	tflog.Trace(ctx, "Finding and deleting default schedule for team", map[string]interface{}{"teamId": teamId})
 
 	baseURL := "/v1/schedules"
 	queryParams := map[string]string{}
@@ -300,7 +321,7 @@ func findAndDeleteDefaultSchedule(teamId string, configuration dto.AtlassianOpsP
 	for !doneLooping && !deleted {
 		var listScheduleDto = dto.ListSchedule{}
 		req := httpClientHelpers.
-			GenerateJsmOpsClientRequest(configuration).
+			GenerateJsmOpsClientRequest(ctx, configuration).
 			JoinBaseUrl(baseURL).
 			Method(httpClient.GET).
 			SetQueryParams(queryParams).
@@ -319,7 +340,7 @@ func findAndDeleteDefaultSchedule(teamId string, configuration dto.AtlassianOpsP
 		for _, schedule := range listScheduleDto.Values {
 			if strings.EqualFold(schedule.TeamId, teamId) {
 				deleteResp, err := httpClientHelpers.
-					GenerateJsmOpsClientRequest(configuration).
+					GenerateJsmOpsClientRequest(ctx, configuration).
 					JoinBaseUrl(fmt.Sprintf("/v1/schedules/%s", schedule.Id)).
 					Method(httpClient.DELETE).
 					Send()
@@ -327,7 +348,7 @@ func findAndDeleteDefaultSchedule(teamId string, configuration dto.AtlassianOpsP
 				if err != nil || deleteResp.IsError() {
 					return fmt.Errorf("error deleting schedule: %w", err)
 				}
-				tflog.Trace(context.Background(), "Deleted default schedule for team", map[string]interface{}{"teamId": teamId, "scheduleId": schedule.Id})
+				tflog.Trace(ctx, "Deleted default schedule for team", map[string]interface{}{"teamId": teamId, "scheduleId": schedule.Id})
 				deleted = true
 				break
 			}
@@ -355,12 +376,12 @@ func findAndDeleteDefaultSchedule(teamId string, configuration dto.AtlassianOpsP
 }
 
 // list escalations using teamId then delete its default escalation
-func findAndDeleteDefaultEscalation(teamId string, configuration dto.AtlassianOpsProviderModel) error {
-	tflog.Trace(context.Background(), "Finding and deleting default escalation for team", map[string]interface{}{"teamId": teamId})
+func findAndDeleteDefaultEscalation(ctx context.Context, teamId string, configuration dto.AtlassianOpsProviderModel) error {
+	tflog.Trace(ctx, "Finding and deleting default escalation for team", map[string]interface{}{"teamId": teamId})
 
 	var listEscalationDto = dto.ListEscalationDto{}
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(configuration).
+		GenerateJsmOpsClientRequest(ctx, configuration).
 		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/escalations", teamId)).
 		Method(httpClient.GET).
 		SetBodyParseObject(&listEscalationDto).
@@ -376,7 +397,7 @@ func findAndDeleteDefaultEscalation(teamId string, configuration dto.AtlassianOp
 
 	for _, escalation := range listEscalationDto.Values {
 		deleteResp, err := httpClientHelpers.
-			GenerateJsmOpsClientRequest(configuration).
+			GenerateJsmOpsClientRequest(ctx, configuration).
 			JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/escalations/%s", teamId, escalation.Id)).
 			Method(httpClient.DELETE).
 			Send()
@@ -384,7 +405,7 @@ func findAndDeleteDefaultEscalation(teamId string, configuration dto.AtlassianOp
 		if err != nil || deleteResp.IsError() {
 			return fmt.Errorf("error deleting escalation: %w", err)
 		}
-		tflog.Trace(context.Background(), "Deleted default escalation for team", map[string]interface{}{"teamId": teamId, "escalationId": escalation.Id})
+		tflog.Trace(ctx, "Deleted default escalation for team", map[string]interface{}{"teamId": teamId, "escalationId": escalation.Id})
 		break
 	}
 
@@ -392,12 +413,12 @@ func findAndDeleteDefaultEscalation(teamId string, configuration dto.AtlassianOp
 }
 
 // list routing rules using teamId then update its Notify to None
-func findAndUpdateDefaultRoutingRule(teamId string, configuration dto.AtlassianOpsProviderModel) error {
-	tflog.Trace(context.Background(), "Finding and updating default routing rule for team", map[string]interface{}{"teamId": teamId})
+func findAndUpdateDefaultRoutingRule(ctx context.Context, teamId string, configuration dto.AtlassianOpsProviderModel) error {
+	tflog.Trace(ctx, "Finding and updating default routing rule for team", map[string]interface{}{"teamId": teamId})
 
 	var listRoutingRuleDto = dto.ListRoutingRuleDto{}
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(configuration).
+		GenerateJsmOpsClientRequest(ctx, configuration).
 		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/routing-rules", teamId)).
 		Method(httpClient.GET).
 		SetBodyParseObject(&listRoutingRuleDto).
@@ -418,7 +439,7 @@ func findAndUpdateDefaultRoutingRule(teamId string, configuration dto.AtlassianO
 				ID:   "",
 			}
 			updateResp, err := httpClientHelpers.
-				GenerateJsmOpsClientRequest(configuration).
+				GenerateJsmOpsClientRequest(ctx, configuration).
 				JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/routing-rules/%s", teamId, rule.ID)).
 				Method(httpClient.PATCH).
 				SetBody(rule).
@@ -427,7 +448,7 @@ func findAndUpdateDefaultRoutingRule(teamId string, configuration dto.AtlassianO
 			if err != nil || updateResp.IsError() {
 				return fmt.Errorf("error updating routing rule: %w", err)
 			}
-			tflog.Trace(context.Background(), "Updated default routing rule for team", map[string]interface{}{"teamId": teamId, "ruleId": rule.ID})
+			tflog.Trace(ctx, "Updated default routing rule for team", map[string]interface{}{"teamId": teamId, "ruleId": rule.ID})
 			break
 		}
 	}
@@ -436,16 +457,20 @@ func findAndUpdateDefaultRoutingRule(teamId string, configuration dto.AtlassianO
 }
 
 func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data dataModels.TeamModel
+	var data dataModels.TeamResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
 
 	tflog.Trace(ctx, "Reading the TeamResource")
 
 	teamDto := dto.TeamDto{}
 
 	httpResp, err := httpClientHelpers.
-		GenerateTeamsClientRequest(r.clientConfiguration).
+		GenerateTeamsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("%s/teams/%s", data.OrganizationId.ValueString(), data.Id.ValueString())).
 		Method(httpClient.GET).
 		SetBodyParseObject(&teamDto).
@@ -480,7 +505,7 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	tflog.Trace(ctx, "Fetching team members")
 
-	memberData, err := r.fetchTeamMembers(data.OrganizationId.ValueString(), data.Id.ValueString())
+	memberData, err := r.fetchTeamMembers(ctx, data.OrganizationId.ValueString(), data.Id.ValueString())
 	if err != nil {
 		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to fetch members for the created team, %s", err.Error()))
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch members for the created team, %s", err.Error()))
@@ -492,7 +517,8 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	tflog.Trace(ctx, "Converting Team Data into Terraform Model")
 
-	data = TeamDtoToModel(teamDto, memberData, data.DeleteDefaultResources)
+	data.TeamModel = TeamDtoToModel(teamDto, memberData, data.DeleteDefaultResources)
+	data.DeletionProtection = deletionProtectionOrDefault(data.DeletionProtection)
 
 	tflog.Trace(ctx, "Read the TeamResource")
 
@@ -501,12 +527,15 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var currentData dataModels.TeamModel
-	var newData dataModels.TeamModel
+	var currentData dataModels.TeamResourceModel
+	var newData dataModels.TeamResourceModel
 
 	req.State.Get(ctx, &currentData)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &newData)...)
 
+	ctx, cancel := withTimeout(ctx, newData.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	if !currentData.OrganizationId.Equal(newData.OrganizationId) && currentData.Id.Equal(newData.Id) {
 		tflog.Error(ctx, "Invalid Update. Organization ID cannot be changed, once a resource is created")
 		resp.Diagnostics.AddError("Invalid Update", "Organization ID cannot be changed, once a resource is created")
@@ -523,11 +552,11 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	tflog.Trace(ctx, "Updating the TeamResource")
 
-	newTeamDto, newUsersDto := TeamModelToDto(ctx, newData)
-	_, currentUsersDto := TeamModelToDto(ctx, currentData)
+	newTeamDto, newUsersDto := TeamModelToDto(ctx, newData.TeamModel)
+	_, currentUsersDto := TeamModelToDto(ctx, currentData.TeamModel)
 
 	httpResp, err := httpClientHelpers.
-		GenerateTeamsClientRequest(r.clientConfiguration).
+		GenerateTeamsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("%s/teams/%s", newData.OrganizationId.ValueString(), newData.Id.ValueString())).
 		Method(httpClient.PATCH).
 		SetBody(newTeamDto).
@@ -563,7 +592,7 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if len(addedUsers) > 0 {
 		tflog.Trace(ctx, "Adding new team members")
 		httpResp, err = httpClientHelpers.
-			GenerateTeamsClientRequest(r.clientConfiguration).
+			GenerateTeamsClientRequest(ctx, r.clientConfiguration).
 			JoinBaseUrl(fmt.Sprintf("%s/teams/%s/members/add", newData.OrganizationId.ValueString(), newData.Id.ValueString())).
 			Method(httpClient.POST).
 			SetBody(dto.TeamMemberList{Members: addedUsers}).
@@ -597,7 +626,7 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		tflog.Trace(ctx, "Removing old team members")
 		removeMembersResponse := dto.PublicApiMembershipRemoveResponse{}
 		httpResp, err = httpClientHelpers.
-			GenerateTeamsClientRequest(r.clientConfiguration).
+			GenerateTeamsClientRequest(ctx, r.clientConfiguration).
 			JoinBaseUrl(fmt.Sprintf("%s/teams/%s/members/remove", currentData.OrganizationId.ValueString(), currentData.Id.ValueString())).
 			Method(httpClient.POST).
 			SetBody(dto.TeamMemberList{Members: removedUsers}).
@@ -631,7 +660,7 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		}
 	}
 
-	newData = TeamDtoToModel(newTeamDto, newUsersDto, newData.DeleteDefaultResources)
+	newData.TeamModel = TeamDtoToModel(newTeamDto, newUsersDto, newData.DeleteDefaultResources)
 
 	tflog.Trace(ctx, "Updated the TeamResource")
 
@@ -640,19 +669,28 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newData)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 	tflog.Trace(ctx, "Saved the TeamResource into Terraform state")
 }
 
 func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data dataModels.TeamModel
+	var data dataModels.TeamResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	checkDeletionProtection(data.DeletionProtection, "team", data.Id.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Trace(ctx, "Deleting the TeamResource")
 
 	httpResp, err := httpClientHelpers.
-		GenerateTeamsClientRequest(r.clientConfiguration).
+		GenerateTeamsClientRequest(ctx, r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("%s/teams/%s", data.OrganizationId.ValueString(), data.Id.ValueString())).
 		Method(httpClient.DELETE).
 		Send()
@@ -684,19 +722,32 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		return
+	}
+
 	idParts := strings.Split(req.ID, ",")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: id,organization_id. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: id,organization_id or team:<name>,organization_id. Got: %q", req.ID),
 		)
 		return
 	}
+	if names, ok := parseImportReference(idParts[0], "team"); ok {
+		teamId, err := resolveTeamId(ctx, r.clientConfiguration, names[0])
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Resolve Import Identifier", err.Error())
+			return
+		}
+		idParts[0] = teamId
+	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[1])...)
 }
 
-func (r *TeamResource) fetchTeamMembers(organizationId string, teamId string) ([]dto.TeamMember, error) {
+func (r *TeamResource) fetchTeamMembers(ctx context.Context, organizationId string, teamId string) ([]dto.TeamMember, error) {
 	var members []dto.TeamMember
 
 	doneLooping := false
@@ -705,7 +756,7 @@ func (r *TeamResource) fetchTeamMembers(organizationId string, teamId string) ([
 		response := dto.TeamMemberListResponse{}
 
 		httpResp, err := httpClientHelpers.
-			GenerateTeamsClientRequest(r.clientConfiguration).
+			GenerateTeamsClientRequest(ctx, r.clientConfiguration).
 			JoinBaseUrl(fmt.Sprintf("/%s/teams/%s/members", organizationId, teamId)).
 			Method("POST").
 			SetBody(request).
@@ -734,9 +785,10 @@ func (r *TeamResource) fetchTeamMembers(organizationId string, teamId string) ([
 	return members, nil
 }
 
-func (r *TeamResource) cleanupTeamSilent(teamDto dto.TeamDto) {
+func (r *TeamResource) cleanupTeamSilent(ctx context.Context, teamDto dto.TeamDto) {
+	// A dangling team is deleted even when the create timeout is what interrupted the creation
 	_, _ = httpClientHelpers.
-		GenerateTeamsClientRequest(r.clientConfiguration).
+		GenerateTeamsClientRequest(context.WithoutCancel(ctx), r.clientConfiguration).
 		JoinBaseUrl(fmt.Sprintf("%s/teams/%s", teamDto.OrganizationId, teamDto.TeamId)).
 		Method(httpClient.DELETE).
 		Send()
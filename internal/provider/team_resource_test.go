@@ -4,9 +4,14 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccTeamResource(t *testing.T) {
@@ -155,3 +160,92 @@ resource "atlassian-operations_team" "example" {
 		},
 	})
 }
+
+func TestAccTeamResource_InvalidTimeouts(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_team" "example" {
+  display_name    = "timeouts"
+  description     = "team with an invalid create timeout"
+  organization_id = "organization"
+  team_type       = "MEMBER_INVITE"
+  member          = []
+
+  timeouts {
+    create = "an hour"
+  }
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+		},
+	})
+}
+
+func TestAccTeamResource_List(t *testing.T) {
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+`,
+			},
+			// List testing
+			{
+				Query: true,
+				Config: providerConfig + `
+list "atlassian-operations_team" "test" {
+  provider = atlassian-operations
+
+  config {
+    organization_id = "` + organizationId + `"
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast("atlassian-operations_team.test", 1),
+					querycheck.ExpectResourceDisplayName("atlassian-operations_team.test", queryfilter.ByDisplayName(knownvalue.StringExact(teamName)), knownvalue.StringExact(teamName)),
+					querycheck.ExpectIdentity("atlassian-operations_team.test", map[string]knownvalue.Check{
+						"id":              knownvalue.NotNull(),
+						"organization_id": knownvalue.StringExact(organizationId),
+					}),
+				},
+			},
+		},
+	})
+}
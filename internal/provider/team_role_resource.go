@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &TeamRoleResource{}
+	_ resource.ResourceWithConfigure      = &TeamRoleResource{}
+	_ resource.ResourceWithImportState    = &TeamRoleResource{}
+	_ resource.ResourceWithIdentity       = &TeamRoleResource{}
+	_ resource.ResourceWithUpgradeState   = &TeamRoleResource{}
+	_ resource.ResourceWithValidateConfig = &TeamRoleResource{}
+)
+
+type TeamRoleResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func NewTeamRoleResource() resource.Resource {
+	return &TeamRoleResource{}
+}
+
+func (r *TeamRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_role"
+}
+
+func (r *TeamRoleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     0,
+		Description: "Manage custom roles of a team in Atlassian Operations. Team roles are assigned to team members with the atlassian-operations_team_member_role resource.",
+		Attributes:  schemaAttributes.TeamRoleResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *TeamRoleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.TeamRoleResourceIdentityAttributes,
+	}
+}
+
+func (r *TeamRoleResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r)
+}
+
+func (r *TeamRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring TeamRoleResource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+	tflog.Trace(ctx, "Configured TeamRoleResource")
+}
+
+func (r *TeamRoleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data dataModels.TeamRoleModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.GrantedRights.IsNull() || data.GrantedRights.IsUnknown() ||
+		data.DisallowedRights.IsNull() || data.DisallowedRights.IsUnknown() {
+		return
+	}
+
+	granted := make(map[string]bool)
+	for _, right := range data.GrantedRights.Elements() {
+		if value, ok := right.(types.String); ok && !value.IsUnknown() {
+			granted[value.ValueString()] = true
+		}
+	}
+	for _, right := range data.DisallowedRights.Elements() {
+		if value, ok := right.(types.String); ok && granted[value.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("disallowed_rights"),
+				"Conflicting Team Role Rights",
+				fmt.Sprintf("The right %s can not be both granted and disallowed.", value.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *TeamRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "Creating TeamRoleResource")
+
+	var data dataModels.TeamRoleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	teamRoleDto, diags := TeamRoleModelToDto(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/roles", data.TeamID.ValueString())).
+		Method(httpClient.POST).
+		SetBody(teamRoleDto).
+		SetBodyParseObject(teamRoleDto).
+		Send()
+
+	handleHttpResponse(httpResp, err, "create team role", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = TeamRoleDtoToModel(data.TeamID.ValueString(), teamRoleDto)
+	data.Timeouts = timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "Created TeamRoleResource")
+}
+
+func (r *TeamRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "Reading TeamRoleResource")
+
+	var data dataModels.TeamRoleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	var teamRoleDto dto.TeamRoleDto
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/roles/%s", data.TeamID.ValueString(), data.ID.ValueString())).
+		Method(httpClient.GET).
+		SetBodyParseObject(&teamRoleDto).
+		Send()
+
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+	handleHttpResponse(httpResp, err, "read team role", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = TeamRoleDtoToModel(data.TeamID.ValueString(), &teamRoleDto)
+	data.Timeouts = timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Read TeamRoleResource")
+}
+
+func (r *TeamRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "Updating TeamRoleResource")
+
+	var data dataModels.TeamRoleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	teamRoleDto, diags := TeamRoleModelToDto(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/roles/%s", data.TeamID.ValueString(), data.ID.ValueString())).
+		Method(httpClient.PATCH).
+		SetBody(teamRoleDto).
+		SetBodyParseObject(teamRoleDto).
+		Send()
+
+	handleHttpResponse(httpResp, err, "update team role", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = TeamRoleDtoToModel(data.TeamID.ValueString(), teamRoleDto)
+	data.Timeouts = timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "Updated TeamRoleResource")
+}
+
+func (r *TeamRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "Deleting TeamRoleResource")
+
+	var data dataModels.TeamRoleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/roles/%s", data.TeamID.ValueString(), data.ID.ValueString())).
+		Method(httpClient.DELETE).
+		Send()
+
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		return
+	}
+	handleHttpResponse(httpResp, err, "delete team role", &resp.Diagnostics, ctx)
+
+	tflog.Trace(ctx, "Deleted TeamRoleResource")
+}
+
+func (r *TeamRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		return
+	}
+
+	importTeamScopedResource(ctx, r.clientConfiguration, req, resp, "role", func(teamId string) string {
+		return fmt.Sprintf("/v1/teams/%s/roles", teamId)
+	}, nil, func(role dto.TeamRoleDto) (string, string) {
+		return role.Name, role.ID
+	})
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccTeamRoleResource(t *testing.T) {
+	teamName := uuid.NewString()
+	roleName := uuid.NewString()
+	roleUpdateName := uuid.NewString()
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	teamConfig := providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: teamConfig + `
+resource "atlassian-operations_team_role" "example" {
+  team_id        = atlassian-operations_team.example.id
+  name           = "` + roleName + `"
+  granted_rights = ["alert-acknowledge", "alert-close"]
+}
+
+resource "atlassian-operations_team_member_role" "example" {
+  team_id    = atlassian-operations_team.example.id
+  account_id = data.atlassian-operations_user.test1.account_id
+  role       = atlassian-operations_team_role.example.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("atlassian-operations_team_role.example", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_team_role.example", "name", roleName),
+					resource.TestCheckResourceAttr("atlassian-operations_team_role.example", "granted_rights.#", "2"),
+					resource.TestCheckTypeSetElemAttr("atlassian-operations_team_role.example", "granted_rights.*", "alert-acknowledge"),
+					resource.TestCheckTypeSetElemAttr("atlassian-operations_team_role.example", "granted_rights.*", "alert-close"),
+					resource.TestCheckResourceAttr("atlassian-operations_team_member_role.example", "role", roleName),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "atlassian-operations_team_role.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return state.RootModule().Resources["atlassian-operations_team_role.example"].Primary.ID +
+							"," +
+							state.RootModule().Resources["atlassian-operations_team_role.example"].Primary.Attributes["team_id"],
+						nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: teamConfig + `
+resource "atlassian-operations_team_role" "example" {
+  team_id        = atlassian-operations_team.example.id
+  name           = "` + roleUpdateName + `"
+  granted_rights    = ["alert-acknowledge", "alert-add-note", "alert-close"]
+  disallowed_rights = ["alert-delete"]
+}
+
+resource "atlassian-operations_team_member_role" "example" {
+  team_id    = atlassian-operations_team.example.id
+  account_id = data.atlassian-operations_user.test1.account_id
+  role       = atlassian-operations_team_role.example.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_team_role.example", "name", roleUpdateName),
+					resource.TestCheckResourceAttr("atlassian-operations_team_role.example", "granted_rights.#", "3"),
+					resource.TestCheckTypeSetElemAttr("atlassian-operations_team_role.example", "granted_rights.*", "alert-add-note"),
+					resource.TestCheckResourceAttr("atlassian-operations_team_role.example", "disallowed_rights.#", "1"),
+					resource.TestCheckTypeSetElemAttr("atlassian-operations_team_role.example", "disallowed_rights.*", "alert-delete"),
+					resource.TestCheckResourceAttr("atlassian-operations_team_member_role.example", "role", roleUpdateName),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTeamRoleResource_ReservedName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_team_role" "example" {
+  team_id        = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name           = "Admin"
+  granted_rights = ["alert-view"]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("value must be none of"),
+			},
+		},
+	})
+}
+
+func TestAccTeamRoleResource_InvalidRights(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unknown right
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_team_role" "example" {
+  team_id        = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name           = "Invalid role"
+  granted_rights = ["manage-schedules"]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("value must be one of"),
+			},
+			// Right both granted and disallowed
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_team_role" "example" {
+  team_id           = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name              = "Invalid role"
+  granted_rights    = ["alert-view", "alert-close"]
+  disallowed_rights = ["alert-close"]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Conflicting Team Role Rights"),
+			},
+		},
+	})
+}
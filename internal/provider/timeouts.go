@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// defaultOperationTimeout bounds create, read, update and delete operations
+// of resources without a configured timeout.
+const defaultOperationTimeout = 20 * time.Minute
+
+func timeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+}
+
+// withTimeout derives a context cancelled once the configured timeout of an
+// operation, e.g. data.Timeouts.Create, elapses. Requests sent with it stop
+// retrying at the deadline.
+func withTimeout(ctx context.Context, timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics), diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	duration, timeoutDiags := timeout(ctx, defaultOperationTimeout)
+	diags.Append(timeoutDiags...)
+
+	return context.WithTimeout(ctx, duration)
+}
@@ -5,22 +5,27 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strings"
 
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/customTypes"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource                = &UserContactResource{}
-	_ resource.ResourceWithConfigure   = &UserContactResource{}
-	_ resource.ResourceWithImportState = &UserContactResource{}
+	_ resource.Resource                 = &UserContactResource{}
+	_ resource.ResourceWithConfigure    = &UserContactResource{}
+	_ resource.ResourceWithImportState  = &UserContactResource{}
+	_ resource.ResourceWithIdentity     = &UserContactResource{}
+	_ resource.ResourceWithUpgradeState = &UserContactResource{}
 )
 
 type UserContactResource struct {
@@ -35,12 +40,26 @@ func (r *UserContactResource) Metadata(_ context.Context, req resource.MetadataR
 	resp.TypeName = req.ProviderTypeName + "_user_contact"
 }
 
-func (r *UserContactResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *UserContactResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:    0,
 		Attributes: schemaAttributes.UserContactResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
+func (r *UserContactResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.UserContactResourceIdentityAttributes,
+	}
+}
+
+func (r *UserContactResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r)
+}
+
 func (r *UserContactResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Trace(ctx, "Configuring UserContactResource")
 
@@ -70,14 +89,17 @@ func (r *UserContactResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Convert to DTO
 	contactDto := UserContactModelToDto(&data)
 
 	// Create user contact
 	var responseDto dto.UserContactCUDResponseDto
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
-		JoinBaseUrl("/v1/users/contacts").
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(userContactsBaseUrl(data.UserId.ValueString())).
 		Method(httpClient.POST).
 		SetBody(contactDto).
 		SetBodyParseObject(&responseDto).
@@ -110,22 +132,28 @@ func (r *UserContactResource) Create(ctx context.Context, req resource.CreateReq
 
 	// Update state with response
 	result := UserContactCUDDtoToModel(&responseDto, &data)
+	result.Timeouts = data.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *UserContactResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data dataModels.UserContactModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Trace(ctx, "Reading UserContactResource")
 
 	var responseDto dto.UserContactDataReadResponseDto
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
-		JoinBaseUrl(fmt.Sprintf("/v1/users/contacts/%s", data.ID.ValueString())).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("%s/%s", userContactsBaseUrl(data.UserId.ValueString()), data.ID.ValueString())).
 		Method(httpClient.GET).
 		SetBodyParseObject(&responseDto).
 		Send()
@@ -159,7 +187,8 @@ func (r *UserContactResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	result := UserContactReadDtoToModel(&responseDto)
+	result := UserContactReadDtoToModel(data.UserId, &responseDto)
+	result.Timeouts = data.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
 }
 
@@ -170,6 +199,9 @@ func (r *UserContactResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Convert to DTO
 	contactDto := UserContactModelToDto(&data)
 
@@ -189,8 +221,8 @@ func (r *UserContactResource) Update(ctx context.Context, req resource.UpdateReq
 	// Check if the contact to field has changed
 	if slices.Contains(methods, "patch") {
 		httpResp, err := httpClientHelpers.
-			GenerateJsmOpsClientRequest(r.clientConfiguration).
-			JoinBaseUrl(fmt.Sprintf("/v1/users/contacts/%s", data.ID.ValueString())).
+			GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+			JoinBaseUrl(fmt.Sprintf("%s/%s", userContactsBaseUrl(data.UserId.ValueString()), data.ID.ValueString())).
 			Method(httpClient.PATCH).
 			SetBody(contactDto).
 			SetBodyParseObject(&responseDto).
@@ -205,8 +237,8 @@ func (r *UserContactResource) Update(ctx context.Context, req resource.UpdateReq
 	// Check if the contact is activated
 	if slices.Contains(methods, "activate") {
 		httpResp, err := httpClientHelpers.
-			GenerateJsmOpsClientRequest(r.clientConfiguration).
-			JoinBaseUrl(fmt.Sprintf("/v1/users/contacts/%s/activate", data.ID.ValueString())).
+			GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+			JoinBaseUrl(fmt.Sprintf("%s/%s/activate", userContactsBaseUrl(data.UserId.ValueString()), data.ID.ValueString())).
 			Method(httpClient.PATCH).
 			SetBodyParseObject(&responseDto).
 			Send()
@@ -220,8 +252,8 @@ func (r *UserContactResource) Update(ctx context.Context, req resource.UpdateReq
 	// Check if the contact is deactivated
 	if slices.Contains(methods, "deactivate") {
 		httpResp, err := httpClientHelpers.
-			GenerateJsmOpsClientRequest(r.clientConfiguration).
-			JoinBaseUrl(fmt.Sprintf("/v1/users/contacts/%s/deactivate", data.ID.ValueString())).
+			GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+			JoinBaseUrl(fmt.Sprintf("%s/%s/deactivate", userContactsBaseUrl(data.UserId.ValueString()), data.ID.ValueString())).
 			Method(httpClient.PATCH).
 			SetBodyParseObject(&responseDto).
 			Send()
@@ -234,14 +266,16 @@ func (r *UserContactResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	result := UserContactCUDDtoToModel(&responseDto, &data)
+	result.Timeouts = data.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *UserContactResource) updateMethodFinder(ctx context.Context, data *dataModels.UserContactModel, resp *resource.UpdateResponse) ([]string, error) {
 	var responseDto dto.UserContactDataReadResponseDto
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
-		JoinBaseUrl(fmt.Sprintf("/v1/users/contacts/%s", data.ID.ValueString())).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("%s/%s", userContactsBaseUrl(data.UserId.ValueString()), data.ID.ValueString())).
 		Method(httpClient.GET).
 		SetBodyParseObject(&responseDto).
 		Send()
@@ -260,7 +294,7 @@ func (r *UserContactResource) updateMethodFinder(ctx context.Context, data *data
 	}
 
 	// Check if the contact to field has changed
-	if data.To.ValueString() != responseDto.To {
+	if customTypes.NormalizeContactAddress(data.To.ValueString()) != customTypes.NormalizeContactAddress(responseDto.To) {
 		changedFields = append(changedFields, "patch")
 	}
 
@@ -302,9 +336,12 @@ func (r *UserContactResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	httpResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(r.clientConfiguration).
-		JoinBaseUrl(fmt.Sprintf("/v1/users/contacts/%s", data.ID.ValueString())).
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("%s/%s", userContactsBaseUrl(data.UserId.ValueString()), data.ID.ValueString())).
 		Method(httpClient.DELETE).
 		Send()
 
@@ -335,5 +372,33 @@ func (r *UserContactResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *UserContactResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		return
+	}
+
+	importUserScopedResource(ctx, req, resp)
+}
+
+func userContactsBaseUrl(userId string) string {
+	if userId == "" {
+		return "/v1/users/contacts"
+	}
+	return fmt.Sprintf("/v1/users/%s/contacts", userId)
+}
+
+// importUserScopedResource imports resources identified either by id, for the authenticated user, or by id,user_id
+func importUserScopedResource(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) > 2 || idParts[0] == "" || (len(idParts) == 2 && idParts[1] == "") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: id or id,user_id. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[0])...)
+	if len(idParts) == 2 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), idParts[1])...)
+	}
 }
@@ -2,9 +2,11 @@ package provider
 
 import (
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccUserContactResource(t *testing.T) {
@@ -65,3 +67,89 @@ resource "atlassian-operations_user_contact" "example" {
 		},
 	})
 }
+
+func TestAccUserContactResource_OtherUser(t *testing.T) {
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailSecondary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_SECONDARY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailSecondary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_SECONDARY must be set for acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test2" {
+	email_address = "` + emailSecondary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_user_contact" "example" {
+  user_id = data.atlassian-operations_user.test2.account_id
+  method  = "email"
+  to      = "kagan+other@opsgenie.com"
+  enabled = true
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("atlassian-operations_user_contact.example", "user_id", "data.atlassian-operations_user.test2", "account_id"),
+					resource.TestCheckResourceAttr("atlassian-operations_user_contact.example", "to", "kagan+other@opsgenie.com"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "atlassian-operations_user_contact.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return state.RootModule().Resources["atlassian-operations_user_contact.example"].Primary.ID +
+							"," +
+							state.RootModule().Resources["atlassian-operations_user_contact.example"].Primary.Attributes["user_id"],
+						nil
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccUserContactResource_InvalidAddress(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_user_contact" "example" {
+  method = "sms"
+  to     = "call me maybe"
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Phone Number"),
+			},
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_user_contact" "example" {
+  method = "voice"
+  to     = "+1 (555) 010-0000-0000-0000"
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Phone Number"),
+			},
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_user_contact" "example" {
+  method = "email"
+  to     = "On-call <oncall@example.com>"
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Email Address"),
+			},
+		},
+	})
+}
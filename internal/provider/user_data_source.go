@@ -89,7 +89,7 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 		var data []dto.UserDto
 		clientResp, err := httpClientHelpers.
-			GenerateUserClientRequest(d.clientConfiguration).
+			GenerateUserClientRequest(ctx, d.clientConfiguration).
 			Method("GET").
 			JoinBaseUrl("/search").
 			SetQueryParams(map[string]string{
@@ -112,7 +112,7 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		}
 
 		clientResp, err = httpClientHelpers.
-			GenerateUserClientRequest(d.clientConfiguration).
+			GenerateUserClientRequest(ctx, d.clientConfiguration).
 			Method("GET").
 			SetQueryParams(map[string]string{
 				"accountId": data[0].AccountId,
@@ -151,7 +151,7 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		var searchResponseDto dto.OrgUserSearchResponseDto
 
 		clientResp, err := httpClientHelpers.
-			GenerateUserClientRequest(d.clientConfiguration).
+			GenerateUserClientRequest(ctx, d.clientConfiguration).
 			Method("GET").
 			JoinBaseUrl(fmt.Sprintf("%s/directories/-/users", model.OrganizationId.ValueString())).
 			SetQueryParams(map[string]string{
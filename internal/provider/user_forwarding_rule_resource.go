@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &UserForwardingRuleResource{}
+	_ resource.ResourceWithConfigure      = &UserForwardingRuleResource{}
+	_ resource.ResourceWithImportState    = &UserForwardingRuleResource{}
+	_ resource.ResourceWithIdentity       = &UserForwardingRuleResource{}
+	_ resource.ResourceWithValidateConfig = &UserForwardingRuleResource{}
+	_ resource.ResourceWithUpgradeState   = &UserForwardingRuleResource{}
+)
+
+type UserForwardingRuleResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func NewUserForwardingRuleResource() resource.Resource {
+	return &UserForwardingRuleResource{}
+}
+
+func (r *UserForwardingRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_forwarding_rule"
+}
+
+func (r *UserForwardingRuleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     0,
+		Description: "Manage forwarding rules in Atlassian Operations. A forwarding rule forwards the notifications of a user to another user between two points in time, e.g. to cover a vacation.",
+		Attributes:  schemaAttributes.UserForwardingRuleResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *UserForwardingRuleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.UserForwardingRuleResourceIdentityAttributes,
+	}
+}
+
+func (r *UserForwardingRuleResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r)
+}
+
+func (r *UserForwardingRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring UserForwardingRuleResource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+	tflog.Trace(ctx, "Configured UserForwardingRuleResource")
+}
+
+func (r *UserForwardingRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data dataModels.UserForwardingRuleModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.FromUserId.IsUnknown() && !data.FromUserId.IsNull() && data.FromUserId.Equal(data.ToUserId) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("to_user_id"),
+			"Invalid Forwarding Rule",
+			"Notifications can not be forwarded to the same user, from_user_id and to_user_id must differ.",
+		)
+	}
+
+	if data.StartDate.IsUnknown() || data.StartDate.IsNull() || data.EndDate.IsUnknown() || data.EndDate.IsNull() {
+		return
+	}
+	startDate, diags := data.StartDate.ValueRFC3339Time()
+	resp.Diagnostics.Append(diags...)
+	endDate, diags := data.EndDate.ValueRFC3339Time()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !endDate.After(startDate) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_date"),
+			"Invalid Forwarding Rule",
+			"end_date must be after start_date.",
+		)
+	}
+}
+
+func (r *UserForwardingRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "Creating UserForwardingRuleResource")
+
+	var data dataModels.UserForwardingRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	forwardingRuleDto := UserForwardingRuleModelToDto(&data)
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl("/v1/forwarding-rules").
+		Method(httpClient.POST).
+		SetBody(forwardingRuleDto).
+		SetBodyParseObject(forwardingRuleDto).
+		Send()
+
+	handleHttpResponse(httpResp, err, "create forwarding rule", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = UserForwardingRuleDtoToModel(forwardingRuleDto)
+	data.Timeouts = timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "Created UserForwardingRuleResource")
+}
+
+func (r *UserForwardingRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "Reading UserForwardingRuleResource")
+
+	var data dataModels.UserForwardingRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	var forwardingRuleDto dto.ForwardingRuleDto
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/forwarding-rules/%s", data.ID.ValueString())).
+		Method(httpClient.GET).
+		SetBodyParseObject(&forwardingRuleDto).
+		Send()
+
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+	handleHttpResponse(httpResp, err, "read forwarding rule", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = UserForwardingRuleDtoToModel(&forwardingRuleDto)
+	data.Timeouts = timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Read UserForwardingRuleResource")
+}
+
+func (r *UserForwardingRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "Updating UserForwardingRuleResource")
+
+	var data dataModels.UserForwardingRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	forwardingRuleDto := UserForwardingRuleModelToDto(&data)
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/forwarding-rules/%s", data.ID.ValueString())).
+		Method(httpClient.PATCH).
+		SetBody(forwardingRuleDto).
+		SetBodyParseObject(forwardingRuleDto).
+		Send()
+
+	handleHttpResponse(httpResp, err, "update forwarding rule", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = UserForwardingRuleDtoToModel(forwardingRuleDto)
+	data.Timeouts = timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "Updated UserForwardingRuleResource")
+}
+
+func (r *UserForwardingRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "Deleting UserForwardingRuleResource")
+
+	var data dataModels.UserForwardingRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/forwarding-rules/%s", data.ID.ValueString())).
+		Method(httpClient.DELETE).
+		Send()
+
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		return
+	}
+	handleHttpResponse(httpResp, err, "delete forwarding rule", &resp.Diagnostics, ctx)
+
+	tflog.Trace(ctx, "Deleted UserForwardingRuleResource")
+}
+
+func (r *UserForwardingRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserForwardingRuleResource(t *testing.T) {
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+	emailSecondary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_SECONDARY")
+
+	usersConfig := providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+data "atlassian-operations_user" "test2" {
+	email_address = "` + emailSecondary + `"
+	organization_id = "` + organizationId + `"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+			if emailSecondary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_SECONDARY must be set for acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: usersConfig + `
+resource "atlassian-operations_user_forwarding_rule" "example" {
+  from_user_id = data.atlassian-operations_user.test1.account_id
+  to_user_id   = data.atlassian-operations_user.test2.account_id
+  start_date   = "2030-07-01T00:00:00Z"
+  end_date     = "2030-07-15T00:00:00Z"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("atlassian-operations_user_forwarding_rule.example", "id"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_user_forwarding_rule.example", "from_user_id", "data.atlassian-operations_user.test1", "account_id"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_user_forwarding_rule.example", "to_user_id", "data.atlassian-operations_user.test2", "account_id"),
+					resource.TestCheckResourceAttr("atlassian-operations_user_forwarding_rule.example", "start_date", "2030-07-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("atlassian-operations_user_forwarding_rule.example", "end_date", "2030-07-15T00:00:00Z"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "atlassian-operations_user_forwarding_rule.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: usersConfig + `
+resource "atlassian-operations_user_forwarding_rule" "example" {
+  from_user_id = data.atlassian-operations_user.test1.account_id
+  to_user_id   = data.atlassian-operations_user.test2.account_id
+  start_date   = "2030-07-01T00:00:00Z"
+  end_date     = "2030-07-22T00:00:00Z"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_user_forwarding_rule.example", "end_date", "2030-07-22T00:00:00Z"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccUserForwardingRuleResource_InvalidPeriod(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_user_forwarding_rule" "example" {
+  from_user_id = "712020:aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+  to_user_id   = "712020:bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+  start_date   = "2030-07-15T00:00:00Z"
+  end_date     = "2030-07-01T00:00:00Z"
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("end_date must be after start_date"),
+			},
+		},
+	})
+}
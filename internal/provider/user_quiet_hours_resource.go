@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                 = &UserQuietHoursResource{}
+	_ resource.ResourceWithConfigure    = &UserQuietHoursResource{}
+	_ resource.ResourceWithImportState  = &UserQuietHoursResource{}
+	_ resource.ResourceWithIdentity     = &UserQuietHoursResource{}
+	_ resource.ResourceWithUpgradeState = &UserQuietHoursResource{}
+)
+
+type UserQuietHoursResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func NewUserQuietHoursResource() resource.Resource {
+	return &UserQuietHoursResource{}
+}
+
+func (r *UserQuietHoursResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_quiet_hours"
+}
+
+func (r *UserQuietHoursResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     0,
+		Description: "Manage the quiet hours of the user the provider authenticates as. Notifications are not sent to the user during quiet hours. Destroying the resource removes the quiet hours.",
+		Attributes:  schemaAttributes.UserQuietHoursResourceAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *UserQuietHoursResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: schemaAttributes.UserQuietHoursResourceIdentityAttributes,
+	}
+}
+
+func (r *UserQuietHoursResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return chainedStateUpgraders(ctx, r)
+}
+
+func (r *UserQuietHoursResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring UserQuietHoursResource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+	tflog.Trace(ctx, "Configured UserQuietHoursResource")
+}
+
+func (r *UserQuietHoursResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "Creating UserQuietHoursResource")
+
+	var data dataModels.UserQuietHoursModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	quietHoursDto, diags := UserQuietHoursModelToDto(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Quiet hours always exist for a user, creating them replaces the current settings
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl("/v1/users/quiet-hours").
+		Method(httpClient.PUT).
+		SetBody(quietHoursDto).
+		SetBodyParseObject(quietHoursDto).
+		Send()
+
+	handleHttpResponse(httpResp, err, "create user quiet hours", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = UserQuietHoursDtoToModel(quietHoursDto)
+	data.Timeouts = timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "Created UserQuietHoursResource")
+}
+
+func (r *UserQuietHoursResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "Reading UserQuietHoursResource")
+
+	var state dataModels.UserQuietHoursModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	setIdentityFromState(ctx, req.State, resp.Identity, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	var quietHoursDto dto.UserQuietHoursDto
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl("/v1/users/quiet-hours").
+		Method(httpClient.GET).
+		SetBodyParseObject(&quietHoursDto).
+		Send()
+
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+	handleHttpResponse(httpResp, err, "read user quiet hours", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Quiet hours removed outside of Terraform
+	if quietHoursDto.TimeRestriction == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	data := UserQuietHoursDtoToModel(&quietHoursDto)
+	data.Timeouts = state.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Read UserQuietHoursResource")
+}
+
+func (r *UserQuietHoursResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "Updating UserQuietHoursResource")
+
+	var data dataModels.UserQuietHoursModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeouts := data.Timeouts
+	ctx, cancel := withTimeout(ctx, timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	quietHoursDto, diags := UserQuietHoursModelToDto(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl("/v1/users/quiet-hours").
+		Method(httpClient.PUT).
+		SetBody(quietHoursDto).
+		SetBodyParseObject(quietHoursDto).
+		Send()
+
+	handleHttpResponse(httpResp, err, "update user quiet hours", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = UserQuietHoursDtoToModel(quietHoursDto)
+	data.Timeouts = timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "Updated UserQuietHoursResource")
+}
+
+func (r *UserQuietHoursResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "Deleting UserQuietHoursResource")
+
+	var data dataModels.UserQuietHoursModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(ctx, r.clientConfiguration).
+		JoinBaseUrl("/v1/users/quiet-hours").
+		Method(httpClient.DELETE).
+		Send()
+
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		return
+	}
+	handleHttpResponse(httpResp, err, "delete user quiet hours", &resp.Diagnostics, ctx)
+
+	tflog.Trace(ctx, "Deleted UserQuietHoursResource")
+}
+
+func (r *UserQuietHoursResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req, resp)
+		return
+	}
+
+	// Quiet hours always belong to the authenticated user, the identifier is only kept in state
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserQuietHoursResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_user_quiet_hours" "example" {
+  time_restriction = {
+    type = "weekday-and-time-of-day"
+    restrictions = [
+      {
+        start_day  = "monday"
+        end_day    = "friday"
+        start_hour = 22
+        end_hour   = 7
+        start_min  = 0
+        end_min    = 0
+      }
+    ]
+  }
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("atlassian-operations_user_quiet_hours.example", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_user_quiet_hours.example", "enabled", "true"),
+					resource.TestCheckResourceAttr("atlassian-operations_user_quiet_hours.example", "time_restriction.type", "weekday-and-time-of-day"),
+					resource.TestCheckResourceAttr("atlassian-operations_user_quiet_hours.example", "time_restriction.restrictions.#", "1"),
+					resource.TestCheckResourceAttr("atlassian-operations_user_quiet_hours.example", "time_restriction.restrictions.0.start_day", "monday"),
+					resource.TestCheckResourceAttr("atlassian-operations_user_quiet_hours.example", "time_restriction.restrictions.0.end_day", "friday"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "atlassian-operations_user_quiet_hours.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_user_quiet_hours" "example" {
+  enabled = false
+  time_restriction = {
+    type = "time-of-day"
+    restriction = {
+      start_hour = 23
+      end_hour   = 6
+      start_min  = 30
+      end_min    = 0
+    }
+  }
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_user_quiet_hours.example", "enabled", "false"),
+					resource.TestCheckResourceAttr("atlassian-operations_user_quiet_hours.example", "time_restriction.type", "time-of-day"),
+					resource.TestCheckResourceAttr("atlassian-operations_user_quiet_hours.example", "time_restriction.restriction.start_hour", "23"),
+					resource.TestCheckResourceAttr("atlassian-operations_user_quiet_hours.example", "time_restriction.restriction.start_min", "30"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}